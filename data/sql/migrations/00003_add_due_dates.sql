@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE items ADD COLUMN due_at DATETIME;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE items DROP COLUMN due_at;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+PRAGMA foreign_keys = ON;
+
+CREATE TABLE subtasks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    item_id INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE
+);
+
+CREATE INDEX subtasks_item_id_position ON subtasks (item_id, position);
+
+CREATE TRIGGER update_item_last_updated_on_subtask_insert
+AFTER INSERT ON subtasks
+BEGIN
+    UPDATE items
+    SET last_updated_at = CURRENT_TIMESTAMP
+    WHERE id = NEW.item_id;
+END;
+
+CREATE TRIGGER update_item_last_updated_on_subtask_update
+AFTER UPDATE ON subtasks
+BEGIN
+    UPDATE items
+    SET last_updated_at = CURRENT_TIMESTAMP
+    WHERE id = NEW.item_id;
+END;
+
+CREATE TRIGGER update_item_last_updated_on_subtask_delete
+AFTER DELETE ON subtasks
+BEGIN
+    UPDATE items
+    SET last_updated_at = CURRENT_TIMESTAMP
+    WHERE id = OLD.item_id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS subtasks;
+-- +goose StatementEnd
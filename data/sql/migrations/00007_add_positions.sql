@@ -0,0 +1,77 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE boards ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE items ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+-- Keep the existing order: boards by id, items by creation date within a board.
+UPDATE boards
+SET position = (SELECT COUNT(*) FROM boards b WHERE b.id < boards.id);
+
+UPDATE items
+SET position = (
+    SELECT COUNT(*) FROM items i
+    WHERE i.board_id = items.board_id
+      AND (i.created_at < items.created_at OR (i.created_at = items.created_at AND i.id < items.id))
+);
+
+CREATE INDEX idx_items_board_id_position ON items(board_id, position);
+
+-- Reordering is not a content change, so it must not bump last_updated_at.
+DROP TRIGGER update_board_last_updated;
+DROP TRIGGER update_item_last_updated;
+DROP TRIGGER update_board_after_item_update;
+
+CREATE TRIGGER update_board_last_updated
+AFTER UPDATE ON boards
+WHEN NEW.position = OLD.position
+BEGIN
+    UPDATE boards SET last_updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER update_item_last_updated
+AFTER UPDATE ON items
+WHEN NEW.position = OLD.position
+BEGIN
+    UPDATE items SET last_updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER update_board_after_item_update
+AFTER UPDATE ON items
+WHEN NEW.position = OLD.position
+BEGIN
+    UPDATE boards
+    SET last_updated_at = CURRENT_TIMESTAMP
+    WHERE id = NEW.board_id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER update_board_last_updated;
+DROP TRIGGER update_item_last_updated;
+DROP TRIGGER update_board_after_item_update;
+
+CREATE TRIGGER update_board_last_updated
+AFTER UPDATE ON boards
+BEGIN
+    UPDATE boards SET last_updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER update_item_last_updated
+AFTER UPDATE ON items
+BEGIN
+    UPDATE items SET last_updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER update_board_after_item_update
+AFTER UPDATE ON items
+BEGIN
+    UPDATE boards
+    SET last_updated_at = CURRENT_TIMESTAMP
+    WHERE id = NEW.board_id;
+END;
+
+DROP INDEX idx_items_board_id_position;
+ALTER TABLE items DROP COLUMN position;
+ALTER TABLE boards DROP COLUMN position;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Moving an item to another board changes its position too, which must still
+-- count as an update of the item and of both boards.
+DROP TRIGGER update_item_last_updated;
+DROP TRIGGER update_board_after_item_update;
+
+CREATE TRIGGER update_item_last_updated
+AFTER UPDATE ON items
+WHEN NEW.position = OLD.position OR NEW.board_id <> OLD.board_id
+BEGIN
+    UPDATE items SET last_updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER update_board_after_item_update
+AFTER UPDATE ON items
+WHEN NEW.position = OLD.position OR NEW.board_id <> OLD.board_id
+BEGIN
+    UPDATE boards
+    SET last_updated_at = CURRENT_TIMESTAMP
+    WHERE id = NEW.board_id;
+END;
+
+CREATE TRIGGER update_source_board_after_item_move
+AFTER UPDATE OF board_id ON items
+WHEN NEW.board_id <> OLD.board_id
+BEGIN
+    UPDATE boards
+    SET last_updated_at = CURRENT_TIMESTAMP
+    WHERE id = OLD.board_id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER update_source_board_after_item_move;
+DROP TRIGGER update_item_last_updated;
+DROP TRIGGER update_board_after_item_update;
+
+CREATE TRIGGER update_item_last_updated
+AFTER UPDATE ON items
+WHEN NEW.position = OLD.position
+BEGIN
+    UPDATE items SET last_updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER update_board_after_item_update
+AFTER UPDATE ON items
+WHEN NEW.position = OLD.position
+BEGIN
+    UPDATE boards
+    SET last_updated_at = CURRENT_TIMESTAMP
+    WHERE id = NEW.board_id;
+END;
+-- +goose StatementEnd
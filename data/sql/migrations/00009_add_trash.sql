@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE boards ADD COLUMN deleted_at DATETIME;
+ALTER TABLE items ADD COLUMN deleted_at DATETIME;
+
+CREATE INDEX idx_boards_deleted_at ON boards(deleted_at);
+CREATE INDEX idx_items_deleted_at ON items(deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_items_deleted_at;
+DROP INDEX idx_boards_deleted_at;
+ALTER TABLE items DROP COLUMN deleted_at;
+ALTER TABLE boards DROP COLUMN deleted_at;
+-- +goose StatementEnd
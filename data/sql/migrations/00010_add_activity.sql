@@ -0,0 +1,144 @@
+-- +goose Up
+-- +goose StatementBegin
+-- activity is an append-only log of changes to boards and items. It has no
+-- foreign keys so the history of purged boards and items is kept; subject
+-- holds the board name or item title at the time of the event.
+CREATE TABLE activity (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    board_id INTEGER NOT NULL,
+    item_id INTEGER,
+    subject TEXT NOT NULL,
+    action TEXT NOT NULL,
+    detail TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_activity_created_at ON activity (created_at);
+CREATE INDEX idx_activity_board_id ON activity (board_id, created_at);
+CREATE INDEX idx_activity_item_id ON activity (item_id, created_at);
+
+CREATE TRIGGER log_board_insert
+AFTER INSERT ON boards
+BEGIN
+    INSERT INTO activity (board_id, subject, action)
+    VALUES (NEW.id, NEW.name, 'created');
+END;
+
+CREATE TRIGGER log_board_rename
+AFTER UPDATE OF name ON boards
+WHEN NEW.name <> OLD.name
+BEGIN
+    INSERT INTO activity (board_id, subject, action, detail)
+    VALUES (NEW.id, NEW.name, 'renamed', OLD.name);
+END;
+
+CREATE TRIGGER log_board_trash
+AFTER UPDATE OF deleted_at ON boards
+WHEN (NEW.deleted_at IS NULL) <> (OLD.deleted_at IS NULL)
+BEGIN
+    INSERT INTO activity (board_id, subject, action)
+    VALUES (NEW.id, NEW.name, CASE WHEN NEW.deleted_at IS NULL THEN 'restored' ELSE 'deleted' END);
+END;
+
+CREATE TRIGGER log_board_delete
+AFTER DELETE ON boards
+BEGIN
+    INSERT INTO activity (board_id, subject, action)
+    VALUES (OLD.id, OLD.name, 'purged');
+END;
+
+CREATE TRIGGER log_item_insert
+AFTER INSERT ON items
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action)
+    VALUES (NEW.board_id, NEW.id, NEW.title, 'created');
+END;
+
+CREATE TRIGGER log_item_rename
+AFTER UPDATE OF title ON items
+WHEN NEW.title <> OLD.title
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action, detail)
+    VALUES (NEW.board_id, NEW.id, NEW.title, 'renamed', OLD.title);
+END;
+
+CREATE TRIGGER log_item_description
+AFTER UPDATE OF description ON items
+WHEN NEW.description <> OLD.description
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action)
+    VALUES (NEW.board_id, NEW.id, NEW.title, 'edited');
+END;
+
+CREATE TRIGGER log_item_completed
+AFTER UPDATE OF completed ON items
+WHEN NEW.completed <> OLD.completed
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action)
+    VALUES (NEW.board_id, NEW.id, NEW.title, CASE WHEN NEW.completed THEN 'completed' ELSE 'reopened' END);
+END;
+
+CREATE TRIGGER log_item_move
+AFTER UPDATE OF board_id ON items
+WHEN NEW.board_id <> OLD.board_id
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action, detail)
+    VALUES (
+        NEW.board_id,
+        NEW.id,
+        NEW.title,
+        'moved',
+        COALESCE((SELECT name FROM boards WHERE id = OLD.board_id), '')
+    );
+END;
+
+CREATE TRIGGER log_item_trash
+AFTER UPDATE OF deleted_at ON items
+WHEN (NEW.deleted_at IS NULL) <> (OLD.deleted_at IS NULL)
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action)
+    VALUES (NEW.board_id, NEW.id, NEW.title, CASE WHEN NEW.deleted_at IS NULL THEN 'restored' ELSE 'deleted' END);
+END;
+
+-- Items purged along with their board are covered by the board event.
+CREATE TRIGGER log_item_delete
+AFTER DELETE ON items
+WHEN EXISTS (SELECT 1 FROM boards WHERE id = OLD.board_id)
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action)
+    VALUES (OLD.board_id, OLD.id, OLD.title, 'purged');
+END;
+
+-- Tags removed along with their item are covered by the item event.
+CREATE TRIGGER log_tag_insert
+AFTER INSERT ON tags
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action, detail)
+    SELECT board_id, id, title, 'tagged', NEW.tag FROM items WHERE id = NEW.item_id;
+END;
+
+CREATE TRIGGER log_tag_delete
+AFTER DELETE ON tags
+BEGIN
+    INSERT INTO activity (board_id, item_id, subject, action, detail)
+    SELECT board_id, id, title, 'untagged', OLD.tag FROM items WHERE id = OLD.item_id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER log_tag_delete;
+DROP TRIGGER log_tag_insert;
+DROP TRIGGER log_item_delete;
+DROP TRIGGER log_item_trash;
+DROP TRIGGER log_item_move;
+DROP TRIGGER log_item_completed;
+DROP TRIGGER log_item_description;
+DROP TRIGGER log_item_rename;
+DROP TRIGGER log_item_insert;
+DROP TRIGGER log_board_delete;
+DROP TRIGGER log_board_trash;
+DROP TRIGGER log_board_rename;
+DROP TRIGGER log_board_insert;
+DROP TABLE IF EXISTS activity;
+-- +goose StatementEnd
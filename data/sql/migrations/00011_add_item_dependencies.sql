@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+PRAGMA foreign_keys = ON;
+
+-- item_dependencies records that item_id is blocked by blocker_id. Items may
+-- depend on items of other boards; cycles are rejected by the service layer.
+CREATE TABLE item_dependencies (
+    item_id INTEGER NOT NULL,
+    blocker_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (item_id, blocker_id),
+    CHECK (item_id <> blocker_id),
+    FOREIGN KEY (item_id) REFERENCES items(id) ON DELETE CASCADE,
+    FOREIGN KEY (blocker_id) REFERENCES items(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_item_dependencies_blocker_id ON item_dependencies (blocker_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS item_dependencies;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- tag_metadata holds presentation details for a tag. Tags without a row
+-- render with the defaults.
+CREATE TABLE tag_metadata (
+    tag TEXT PRIMARY KEY,
+    color TEXT NOT NULL DEFAULT '',
+    emoji TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    last_updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tag_metadata;
+-- +goose StatementEnd
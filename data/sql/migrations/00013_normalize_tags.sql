@@ -0,0 +1,73 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Brings existing tags into the canonical form enforced by
+-- service.NormalizeTag: lower case, levels split on "/" with surrounding
+-- whitespace trimmed, inner whitespace turned into "-", empty levels dropped
+-- and at most 64 characters. Characters NormalizeTag rejects cannot fail the
+-- migration, so they are replaced with "-". Variants such as "Work" and
+-- "work " are merged into a single tag; metadata of the canonical tag wins
+-- over its variants, otherwise the most recently edited variant is kept.
+-- SQLite only folds and checks ASCII characters, other characters are kept
+-- as they are and normalized the next time the item is saved.
+--
+-- Retagging fires the activity triggers; the rows they add are removed again
+-- at the end, as the migration is not something the user did.
+CREATE TEMP TABLE activity_mark AS
+SELECT COALESCE(MAX(id), 0) AS id FROM activity;
+
+CREATE TEMP TABLE tag_renames AS
+WITH RECURSIVE walk (old, rest, out) AS (
+    SELECT tag, lower(tag), ''
+    FROM (SELECT DISTINCT tag FROM tags)
+    UNION ALL
+    SELECT
+        old,
+        substr(rest, 2),
+        CASE
+            WHEN substr(rest, 1, 1) IN (' ', char(9), char(10), char(11), char(12), char(13)) THEN
+                CASE WHEN out = '' OR substr(out, -1) IN (' ', '/') THEN out ELSE out || ' ' END
+            WHEN substr(rest, 1, 1) = '/' THEN
+                CASE WHEN out = '' OR substr(out, -1) = '/' THEN out ELSE rtrim(out, ' ') || '/' END
+            WHEN substr(rest, 1, 1) GLOB '[a-z0-9_.-]' OR unicode(substr(rest, 1, 1)) > 127 THEN
+                out || substr(rest, 1, 1)
+            ELSE out || '-'
+        END
+    FROM walk
+    WHERE rest <> ''
+)
+SELECT old, new
+FROM (
+    SELECT old, rtrim(substr(replace(rtrim(out, ' /'), ' ', '-'), 1, 64), '/') AS new
+    FROM walk
+    WHERE rest = ''
+)
+WHERE new <> old;
+
+INSERT OR IGNORE INTO tags (item_id, tag)
+SELECT t.item_id, r.new
+FROM tags t
+JOIN tag_renames r ON r.old = t.tag
+WHERE r.new <> '';
+
+DELETE FROM tags WHERE tag IN (SELECT old FROM tag_renames);
+
+INSERT OR IGNORE INTO tag_metadata (tag, color, emoji, description, last_updated_at)
+SELECT r.new, m.color, m.emoji, m.description, m.last_updated_at
+FROM tag_metadata m
+JOIN tag_renames r ON r.old = m.tag
+WHERE r.new <> ''
+ORDER BY m.last_updated_at DESC;
+
+DELETE FROM tag_metadata WHERE tag IN (SELECT old FROM tag_renames);
+
+DROP TABLE tag_renames;
+
+DELETE FROM activity WHERE id > (SELECT id FROM activity_mark);
+
+DROP TABLE activity_mark;
+-- +goose StatementEnd
+
+-- +goose Down
+-- Irreversible on purpose: the original spelling of merged and rewritten tags
+-- is not kept, so there is nothing to restore. Rolling back only lowers the
+-- version, the tags stay in canonical form.
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- board_default_tags holds the tags every new item of a board starts with.
+CREATE TABLE board_default_tags (
+    board_id INTEGER NOT NULL,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (board_id, tag),
+    FOREIGN KEY (board_id) REFERENCES boards(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS board_default_tags;
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+-- name: ListActivity :many
+SELECT * FROM activity
+WHERE (sqlc.narg(item_id) IS NULL OR item_id = sqlc.narg(item_id))
+  AND (sqlc.narg(board_id) IS NULL OR board_id = sqlc.narg(board_id))
+  AND (sqlc.narg(since) IS NULL OR created_at >= sqlc.narg(since))
+  AND (sqlc.narg(until) IS NULL OR created_at < sqlc.narg(until))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit);
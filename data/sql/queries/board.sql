@@ -1,14 +1,20 @@
 -- name: CreateBoard :one
 INSERT INTO boards (
-  name
+  name, position
 ) VALUES (
-  ?
+  ?1, (SELECT COALESCE(MAX(position) + 1, 0) FROM boards)
 )
 RETURNING *;
 
 -- name: ListBoards :many
 SELECT * FROM boards
-ORDER BY id;
+WHERE deleted_at IS NULL
+ORDER BY position, id;
+
+-- name: ListDeletedBoards :many
+SELECT * FROM boards
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id;
 
 -- name: GetBoardByID :one
 SELECT * FROM boards
@@ -21,6 +27,55 @@ last_updated_at = CURRENT_TIMESTAMP
 WHERE boards.id = ?
 RETURNING *;
 
+-- name: SoftDeleteBoardByID :exec
+UPDATE boards
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: RestoreBoardByID :exec
+UPDATE boards
+SET
+    deleted_at = NULL,
+    last_updated_at = CURRENT_TIMESTAMP,
+    position = (SELECT COALESCE(MAX(b.position) + 1, 0) FROM boards b WHERE b.deleted_at IS NULL)
+WHERE boards.id = ?1;
+
 -- name: DeleteBoardByID :exec
 DELETE FROM boards
 WHERE id = ?;
+
+-- name: PurgeDeletedBoards :execrows
+DELETE FROM boards
+WHERE deleted_at IS NOT NULL AND deleted_at < datetime('now', sqlc.arg(age));
+
+-- name: SetBoardPositionByID :exec
+UPDATE boards
+SET position = ?
+WHERE id = ?;
+
+-- name: ListBoardDefaultTags :many
+SELECT tag FROM board_default_tags
+WHERE board_id = ?
+ORDER BY tag;
+
+-- name: ListAllBoardDefaultTags :many
+SELECT board_id, tag FROM board_default_tags
+ORDER BY board_id, tag;
+
+-- name: AddBoardDefaultTag :exec
+INSERT INTO board_default_tags (board_id, tag)
+VALUES (?, ?)
+ON CONFLICT(board_id, tag) DO NOTHING;
+
+-- name: ClearBoardDefaultTags :exec
+DELETE FROM board_default_tags
+WHERE board_id = ?;
+
+-- name: RenameBoardDefaultTag :exec
+UPDATE OR REPLACE board_default_tags
+SET tag = sqlc.arg(target)
+WHERE tag = sqlc.arg(source);
+
+-- name: DeleteBoardDefaultTag :exec
+DELETE FROM board_default_tags
+WHERE tag = ?;
@@ -0,0 +1,16 @@
+-- name: AddItemDependency :exec
+INSERT INTO item_dependencies (item_id, blocker_id)
+VALUES (?, ?)
+ON CONFLICT(item_id, blocker_id) DO NOTHING;
+
+-- name: RemoveItemDependency :exec
+DELETE FROM item_dependencies
+WHERE item_id = ? AND blocker_id = ?;
+
+-- name: ListItemDependencies :many
+SELECT item_id, blocker_id FROM item_dependencies;
+
+-- name: ListBlockerIDsByItemID :many
+SELECT blocker_id FROM item_dependencies
+WHERE item_id = ?
+ORDER BY blocker_id;
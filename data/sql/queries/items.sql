@@ -1,10 +1,10 @@
 -- name: CreateItem :one
 INSERT INTO items (
-    board_id, title, description
+    board_id, title, description, due_at, position
 ) VALUES (
-    ?, ?, ?
+    ?1, ?2, ?3, ?4, (SELECT COALESCE(MAX(position) + 1, 0) FROM items WHERE board_id = ?1)
 )
-RETURNING id, board_id, title, description, completed, created_at, last_updated_at;
+RETURNING id, board_id, title, description, completed, created_at, last_updated_at, due_at, priority, recurrence, position, deleted_at;
 
 -- name: UpdateItemByID :one
 UPDATE items
@@ -12,14 +12,67 @@ SET
     title = ?,
     description = ?,
     completed = ?,
+    due_at = ?,
+    priority = ?,
+    recurrence = ?,
     last_updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, board_id, title, description, completed, created_at, last_updated_at;
+RETURNING id, board_id, title, description, completed, created_at, last_updated_at, due_at, priority, recurrence, position, deleted_at;
+
+-- name: MoveItemToBoardByID :one
+UPDATE items
+SET
+    board_id = ?1,
+    position = (SELECT COALESCE(MAX(i.position) + 1, 0) FROM items i WHERE i.board_id = ?1),
+    last_updated_at = CURRENT_TIMESTAMP
+WHERE items.id = ?2
+RETURNING id, board_id, title, description, completed, created_at, last_updated_at, due_at, priority, recurrence, position, deleted_at;
+
+-- name: SoftDeleteItemByID :exec
+UPDATE items
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: RestoreItemByID :exec
+UPDATE items
+SET
+    deleted_at = NULL,
+    last_updated_at = CURRENT_TIMESTAMP,
+    position = (
+        SELECT COALESCE(MAX(i.position) + 1, 0)
+        FROM items i
+        WHERE i.board_id = items.board_id AND i.deleted_at IS NULL
+    )
+WHERE items.id = ?1;
 
 -- name: DeleteItemByID :exec
 DELETE FROM items
 WHERE id = ?;
 
+-- name: PurgeDeletedItems :execrows
+DELETE FROM items
+WHERE deleted_at IS NOT NULL AND deleted_at < datetime('now', sqlc.arg(age));
+
+-- name: ListDeletedItems :many
+SELECT
+    i.id,
+    i.board_id,
+    i.title,
+    i.description,
+    i.completed,
+    i.created_at,
+    i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    i.deleted_at,
+    b.name AS board_name
+FROM items i
+JOIN boards b ON b.id = i.board_id
+WHERE i.deleted_at IS NOT NULL
+ORDER BY i.deleted_at DESC, i.id;
+
 -- name: GetItemByID :one
 SELECT
     i.id,
@@ -29,12 +82,53 @@ SELECT
     i.completed,
     i.created_at,
     i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
     COALESCE(json_group_array(t.tag), '[]') AS tags
 FROM items i
 LEFT JOIN tags t ON i.id = t.item_id
 WHERE i.id = ?
 GROUP BY i.id;
 
+-- name: GetActiveItemByID :one
+-- GetActiveItemByID returns an item unless it or its board is in the trash.
+SELECT
+    i.id,
+    i.board_id,
+    i.title,
+    i.description,
+    i.completed,
+    i.created_at,
+    i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
+    COALESCE((SELECT json_group_array(t.tag) FROM tags t WHERE t.item_id = i.id), '[]') AS tags
+FROM items i
+JOIN boards b ON b.id = i.board_id
+WHERE i.id = ? AND i.deleted_at IS NULL AND b.deleted_at IS NULL;
+
 -- name: ListItemsByBoardID :many
 SELECT
     i.id,
@@ -44,9 +138,27 @@ SELECT
     i.completed,
     i.created_at,
     i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
     COALESCE(json_group_array(t.tag), '[]') AS tags
 FROM items i
 LEFT JOIN tags t ON i.id = t.item_id
-WHERE i.board_id = ?
+WHERE i.board_id = ? AND i.deleted_at IS NULL
 GROUP BY i.id
-ORDER BY i.created_at;
+ORDER BY i.position, i.id;
+
+-- name: SetItemPositionByID :exec
+UPDATE items
+SET position = ?
+WHERE id = ?;
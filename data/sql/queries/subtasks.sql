@@ -0,0 +1,33 @@
+-- name: CreateSubtask :one
+INSERT INTO subtasks (
+    item_id, title, position
+) VALUES (
+    ?1, ?2, (SELECT COALESCE(MAX(position) + 1, 0) FROM subtasks WHERE item_id = ?1)
+)
+RETURNING *;
+
+-- name: GetSubtaskByID :one
+SELECT * FROM subtasks
+WHERE id = ? LIMIT 1;
+
+-- name: ListSubtasksByItemID :many
+SELECT * FROM subtasks
+WHERE item_id = ?
+ORDER BY position, id;
+
+-- name: UpdateSubtaskByID :one
+UPDATE subtasks
+SET
+    title = ?,
+    completed = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: SetSubtaskPositionByID :exec
+UPDATE subtasks
+SET position = ?
+WHERE id = ?;
+
+-- name: DeleteSubtaskByID :exec
+DELETE FROM subtasks
+WHERE id = ?;
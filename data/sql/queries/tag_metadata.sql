@@ -0,0 +1,28 @@
+-- name: GetTagMetadata :one
+SELECT tag, color, emoji, description, last_updated_at
+FROM tag_metadata
+WHERE tag = ?;
+
+-- name: ListTagMetadata :many
+SELECT tag, color, emoji, description, last_updated_at
+FROM tag_metadata
+ORDER BY tag;
+
+-- name: UpsertTagMetadata :one
+INSERT INTO tag_metadata (tag, color, emoji, description)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(tag) DO UPDATE SET
+    color = excluded.color,
+    emoji = excluded.emoji,
+    description = excluded.description,
+    last_updated_at = CURRENT_TIMESTAMP
+RETURNING tag, color, emoji, description, last_updated_at;
+
+-- name: RenameTagMetadata :exec
+UPDATE OR REPLACE tag_metadata
+SET tag = sqlc.arg(target) || substr(tag, length(CAST(sqlc.arg(source) AS TEXT)) + 1), last_updated_at = CURRENT_TIMESTAMP
+WHERE tag = sqlc.arg(source) OR substr(tag, 1, length(sqlc.arg(source)) + 1) = sqlc.arg(source) || '/';
+
+-- name: DeleteTagMetadata :exec
+DELETE FROM tag_metadata
+WHERE tag = ?;
@@ -1,5 +1,19 @@
 -- name: ListTags :many
-SELECT DISTINCT tag FROM tags ORDER BY tag;
+SELECT DISTINCT t.tag
+FROM tags t
+JOIN items i ON i.id = t.item_id
+JOIN boards b ON b.id = i.board_id
+WHERE i.deleted_at IS NULL AND b.deleted_at IS NULL
+ORDER BY t.tag;
+
+-- name: ListTagUsage :many
+SELECT t.tag, COUNT(*) AS uses
+FROM tags t
+JOIN items i ON i.id = t.item_id
+JOIN boards b ON b.id = i.board_id
+WHERE i.deleted_at IS NULL AND b.deleted_at IS NULL
+GROUP BY t.tag
+ORDER BY uses DESC, t.tag;
 
 -- name: ListTagsByItemID :many
 SELECT tag FROM tags
@@ -7,6 +21,8 @@ WHERE item_id = ?
 ORDER BY tag;
 
 -- name: ListItemsByTag :many
+-- Tags form a hierarchy separated by "/": a tag matches itself and every tag
+-- below it, so "work" matches "work" and "work/infra" but not "workshop".
 SELECT
     i.id,
     i.board_id,
@@ -15,19 +31,38 @@ SELECT
     i.completed,
     i.created_at,
     i.last_updated_at,
-    COALESCE(json_group_array(t2.tag), '[]') AS tags
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
+    COALESCE(json_group_array(t.tag), '[]') AS tags
 FROM items i
-JOIN tags t ON i.id = t.item_id
-LEFT JOIN tags t2 ON i.id = t2.item_id
-WHERE t.tag = ?
+JOIN boards b ON b.id = i.board_id
+LEFT JOIN tags t ON i.id = t.item_id
+WHERE EXISTS (
+    SELECT 1 FROM tags m
+    WHERE m.item_id = i.id
+      AND (m.tag = sqlc.arg(tag) OR substr(m.tag, 1, length(sqlc.arg(tag)) + 1) = sqlc.arg(tag) || '/')
+) AND i.deleted_at IS NULL AND b.deleted_at IS NULL
 GROUP BY i.id
 ORDER BY i.created_at;
 
 -- name: CountItemsByTag :one
-SELECT COUNT(*)
+SELECT COUNT(DISTINCT i.id)
 FROM items i
+JOIN boards b ON b.id = i.board_id
 JOIN tags t ON i.id = t.item_id
-WHERE t.tag = ?;
+WHERE (t.tag = sqlc.arg(tag) OR substr(t.tag, 1, length(sqlc.arg(tag)) + 1) = sqlc.arg(tag) || '/')
+  AND i.deleted_at IS NULL AND b.deleted_at IS NULL;
 
 -- name: AddTagToItemByID :exec
 INSERT INTO tags (item_id, tag)
@@ -41,3 +76,52 @@ WHERE item_id = ? AND tag = ?;
 -- name: DeleteTag :exec
 DELETE FROM tags
 WHERE tag = ?;
+
+-- name: TagExists :one
+SELECT EXISTS (SELECT 1 FROM tags WHERE tag = ?);
+
+-- name: CopyTag :exec
+INSERT INTO tags (item_id, tag)
+SELECT t.item_id, sqlc.arg(target) FROM tags t WHERE t.tag = sqlc.arg(source)
+ON CONFLICT(item_id, tag) DO NOTHING;
+
+-- name: ListTagSubtree :many
+-- Tags at or below tag, whether items, board defaults or metadata hold them.
+SELECT tag FROM tags
+WHERE tag = sqlc.arg(tag) OR substr(tag, 1, length(sqlc.arg(tag)) + 1) = sqlc.arg(tag) || '/'
+UNION
+SELECT tag FROM board_default_tags
+WHERE tag = sqlc.arg(tag) OR substr(tag, 1, length(sqlc.arg(tag)) + 1) = sqlc.arg(tag) || '/'
+UNION
+SELECT tag FROM tag_metadata
+WHERE tag = sqlc.arg(tag) OR substr(tag, 1, length(sqlc.arg(tag)) + 1) = sqlc.arg(tag) || '/'
+ORDER BY tag;
+
+-- name: ListTagSummaries :many
+-- Every tag and each of its parents, counting the items of the whole subtree
+-- once each. Parents that are never used directly are included as well.
+WITH RECURSIVE paths AS (
+    SELECT
+        t.item_id AS item_id,
+        CASE WHEN instr(t.tag, '/') = 0 THEN t.tag ELSE substr(t.tag, 1, instr(t.tag, '/') - 1) END AS tag,
+        CASE WHEN instr(t.tag, '/') = 0 THEN '' ELSE substr(t.tag, instr(t.tag, '/') + 1) END AS rest
+    FROM tags t
+    UNION
+    SELECT
+        p.item_id,
+        p.tag || '/' || CASE WHEN instr(p.rest, '/') = 0 THEN p.rest ELSE substr(p.rest, 1, instr(p.rest, '/') - 1) END,
+        CASE WHEN instr(p.rest, '/') = 0 THEN '' ELSE substr(p.rest, instr(p.rest, '/') + 1) END
+    FROM paths p
+    WHERE p.rest <> ''
+)
+SELECT
+    CAST(p.tag AS TEXT) AS tag,
+    COUNT(DISTINCT i.id) AS total,
+    COUNT(DISTINCT CASE WHEN NOT i.completed THEN i.id END) AS open,
+    CAST(MAX(i.last_updated_at) AS TEXT) AS last_activity_at
+FROM paths p
+JOIN items i ON i.id = p.item_id
+JOIN boards b ON b.id = i.board_id
+WHERE i.deleted_at IS NULL AND b.deleted_at IS NULL
+GROUP BY p.tag
+ORDER BY p.tag;
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+//nolint:gochecknoglobals // static command table
+var boardCommands = []command{
+	{name: "list", args: "[flags]", summary: "List boards", run: (*CLI).boardList},
+	{name: "add", args: "NAME", summary: "Create a board and print its id", run: (*CLI).boardAdd},
+	{name: "rename", args: "BOARD NAME", summary: "Rename a board", run: (*CLI).boardRename},
+	{name: "rm", args: "BOARD", summary: "Move a board to the trash", run: (*CLI).boardRemove},
+}
+
+//nolint:gochecknoglobals // static output schema
+var boardSchema = schema[service.Board]{
+	fields: []field[service.Board]{
+		{name: "id", value: func(b service.Board) any { return b.ID }},
+		{name: "name", value: func(b service.Board) any { return b.Name }},
+		{name: "defaultTags", value: func(b service.Board) any { return b.DefaultTags }},
+		{name: "createdAt", value: func(b service.Board) any { return b.CreatedAt }},
+		{name: "lastUpdatedAt", value: func(b service.Board) any { return b.LastUpdatedAt }},
+	},
+	table: []string{"id", "name", "defaultTags"},
+}
+
+func (c *CLI) boardList(ctx context.Context, in *invocation) error {
+	opts := in.outputFlags()
+	if _, err := in.parse(0, 0); err != nil {
+		return err
+	}
+	if err := opts.validate(boardSchema.names()); err != nil {
+		return err
+	}
+	boards, err := c.svc.ListBoards(ctx)
+	if err != nil {
+		return err
+	}
+	return writeRecords(c.stdout, boardSchema, opts, *boards)
+}
+
+func (c *CLI) boardAdd(ctx context.Context, in *invocation) error {
+	args, err := in.parse(1, 1)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(args[0])
+	if name == "" {
+		return &usageError{msg: "board name must not be empty"}
+	}
+	board, err := c.svc.CreateBoard(ctx, name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout, board.ID)
+	return nil
+}
+
+func (c *CLI) boardRename(ctx context.Context, in *invocation) error {
+	args, err := in.parse(2, 2)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(args[1])
+	if name == "" {
+		return &usageError{msg: "board name must not be empty"}
+	}
+	board, err := c.resolveBoard(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	board.Name = name
+	_, err = c.svc.UpdateBoard(ctx, board)
+	return err
+}
+
+func (c *CLI) boardRemove(ctx context.Context, in *invocation) error {
+	args, err := in.parse(1, 1)
+	if err != nil {
+		return err
+	}
+	board, err := c.resolveBoard(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return c.svc.DeleteBoard(ctx, board)
+}
+
+// resolveBoard finds a board by id or, failing that, by name. Names are matched
+// exactly first and then ignoring case, as long as only one board matches.
+func (c *CLI) resolveBoard(ctx context.Context, ref string) (*service.Board, error) {
+	boards, err := c.svc.ListBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if id, parseErr := strconv.ParseInt(ref, 10, 64); parseErr == nil {
+		for i := range *boards {
+			if (*boards)[i].ID == id {
+				return &(*boards)[i], nil
+			}
+		}
+	}
+	for _, match := range []func(string) bool{
+		func(name string) bool { return name == ref },
+		func(name string) bool { return strings.EqualFold(name, ref) },
+	} {
+		var found []*service.Board
+		for i := range *boards {
+			if match((*boards)[i].Name) {
+				found = append(found, &(*boards)[i])
+			}
+		}
+		switch len(found) {
+		case 0:
+			continue
+		case 1:
+			return found[0], nil
+		default:
+			return nil, fmt.Errorf("board name %q is ambiguous, use its id", ref)
+		}
+	}
+	return nil, &notFoundError{kind: "board", ref: ref}
+}
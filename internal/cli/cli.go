@@ -0,0 +1,256 @@
+// Package cli implements the non-interactive donezo subcommands, so boards,
+// items and tags can be managed from shells, cron jobs and git hooks.
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/migrate"
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+// Exit codes returned by Run.
+const (
+	ExitOK       = 0
+	ExitError    = 1
+	ExitUsage    = 2
+	ExitNotFound = 3
+)
+
+// usageError reports a command invoked with the wrong arguments.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// notFoundError reports a board, item or tag that does not exist.
+type notFoundError struct {
+	kind string
+	ref  string
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("%s %q not found", e.kind, e.ref)
+}
+
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(c *CLI, ctx context.Context, in *invocation) error
+}
+
+type group struct {
+	name     string
+	summary  string
+	commands []command
+}
+
+// commands are run without a group, as in "donezo add".
+//
+//nolint:gochecknoglobals // static command table
+var commands = []command{
+	{name: "add", args: "[flags] TEXT...", summary: "Create an item from a quick-add line", run: (*CLI).quickAdd},
+	{
+		name:    "completion",
+		args:    "bash|zsh|fish",
+		summary: "Print the shell completion script",
+		run:     (*CLI).completion,
+	},
+}
+
+//nolint:gochecknoglobals // static command table
+var groups = []group{
+	{name: "board", summary: "Manage boards", commands: boardCommands},
+	{name: "item", summary: "Manage items", commands: itemCommands},
+	{name: "tag", summary: "Manage tags", commands: tagCommands},
+	{name: "db", summary: "Manage the database schema", commands: dbCommands},
+}
+
+// CLI runs subcommands against a service, reading answers to prompts from
+// stdin, writing results to stdout and errors to stderr.
+type CLI struct {
+	svc      *service.Service
+	migrator *migrate.Migrator
+	stdin    io.Reader
+	stdout   io.Writer
+	stderr   io.Writer
+}
+
+// IsCommand reports whether args start with a subcommand rather than being
+// empty, in which case the TUI is started.
+func IsCommand(args []string) bool {
+	return len(args) > 0
+}
+
+// ManagesSchema reports whether args run a db command, which works on
+// databases whose migrations are not applied.
+func ManagesSchema(args []string) bool {
+	return len(args) > 0 && args[0] == "db"
+}
+
+// IsCompletion reports whether args ask for completion candidates, which
+// must leave the database untouched.
+func IsCompletion(args []string) bool {
+	return len(args) > 0 && args[0] == CompleteCommand
+}
+
+// Run executes the subcommand in args and returns the exit code.
+func Run(
+	ctx context.Context,
+	svc *service.Service,
+	migrator *migrate.Migrator,
+	args []string,
+	stdin io.Reader,
+	stdout, stderr io.Writer,
+) int {
+	c := &CLI{svc: svc, migrator: migrator, stdin: stdin, stdout: stdout, stderr: stderr}
+	return c.exit(c.dispatch(ctx, args))
+}
+
+func (c *CLI) exit(err error) int {
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	}
+	fmt.Fprintf(c.stderr, "donezo: %v\n", err)
+	var usage *usageError
+	var notFound *notFoundError
+	switch {
+	case errors.As(err, &usage):
+		return ExitUsage
+	case errors.As(err, &notFound):
+		return ExitNotFound
+	default:
+		return ExitError
+	}
+}
+
+func (c *CLI) dispatch(ctx context.Context, args []string) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		PrintUsage(c.stdout)
+		return nil
+	}
+	if args[0] == CompleteCommand {
+		return c.complete(ctx, args[1:])
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(c, ctx, newInvocation(cmd.name, cmd, args[1:], c.stderr))
+		}
+	}
+	for _, g := range groups {
+		if g.name != args[0] {
+			continue
+		}
+		if len(args) < 2 {
+			return &usageError{msg: "missing command\n" + g.usage()}
+		}
+		for _, cmd := range g.commands {
+			if cmd.name == args[1] {
+				return cmd.run(c, ctx, newInvocation(g.name+" "+cmd.name, cmd, args[2:], c.stderr))
+			}
+		}
+		return &usageError{msg: fmt.Sprintf("unknown command %q\n%s", g.name+" "+args[1], g.usage())}
+	}
+	return &usageError{msg: fmt.Sprintf("unknown command %q, run \"donezo help\" for usage", args[0])}
+}
+
+func (g group) usage() string {
+	var b strings.Builder
+	b.WriteString("usage:\n")
+	for _, cmd := range g.commands {
+		fmt.Fprintf(&b, "  donezo %s %s %s\n", g.name, cmd.name, cmd.args)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// PrintUsage writes the list of subcommands to w.
+func PrintUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: donezo [flags] [command]")
+	fmt.Fprintln(w, "\nWithout a command donezo starts the TUI. Commands:")
+	fmt.Fprintln(w)
+	for _, cmd := range commands {
+		synopsis := strings.TrimSpace(cmd.name + " " + cmd.args)
+		fmt.Fprintf(w, "  %-40s %s\n", synopsis, cmd.summary)
+	}
+	for _, g := range groups {
+		fmt.Fprintln(w)
+		for _, cmd := range g.commands {
+			synopsis := strings.TrimSpace(g.name + " " + cmd.name + " " + cmd.args)
+			fmt.Fprintf(w, "  %-40s %s\n", synopsis, cmd.summary)
+		}
+	}
+	fmt.Fprintln(w, "\nBoards are addressed by id or name, items by id.")
+	fmt.Fprintf(w, "Exit codes: %d ok, %d error, %d usage, %d not found.\n",
+		ExitOK, ExitError, ExitUsage, ExitNotFound)
+}
+
+// invocation holds the flags and arguments of a single command.
+type invocation struct {
+	*flag.FlagSet
+
+	synopsis string
+	args     []string
+}
+
+// newInvocation prepares cmd, invoked as "donezo <name>", to parse args.
+func newInvocation(name string, cmd command, args []string, stderr io.Writer) *invocation {
+	in := &invocation{
+		FlagSet:  flag.NewFlagSet("donezo "+name, flag.ContinueOnError),
+		synopsis: strings.TrimSpace("donezo " + name + " " + cmd.args),
+		args:     args,
+	}
+	in.SetOutput(stderr)
+	in.Usage = func() {
+		fmt.Fprintf(stderr, "usage: %s\n\n%s\n", in.synopsis, cmd.summary)
+		in.PrintDefaults()
+	}
+	return in
+}
+
+// parse parses flags anywhere among the arguments and returns the positional
+// ones, which must number between minArgs and maxArgs; a negative maxArgs
+// means no upper limit.
+func (in *invocation) parse(minArgs, maxArgs int) ([]string, error) {
+	var positional []string
+	args := in.args
+	for {
+		if err := in.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, &usageError{msg: err.Error()}
+		}
+		rest := in.Args()
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			positional = append(positional, rest...)
+			break
+		}
+		if len(rest) == 0 {
+			break
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+	if len(positional) < minArgs || (maxArgs >= 0 && len(positional) > maxArgs) {
+		return nil, &usageError{msg: "usage: " + in.synopsis}
+	}
+	return positional, nil
+}
+
+// visited returns the names of the flags set on the command line.
+func (in *invocation) visited() map[string]bool {
+	set := make(map[string]bool)
+	in.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	return set
+}
@@ -0,0 +1,286 @@
+package cli_test
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/cli"
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+// run executes a command line and returns its exit code, stdout and stderr.
+func run(t *testing.T, svc *service.Service, args ...string) (int, string, string) {
+	t.Helper()
+	return runWithInput(t, svc, "", args...)
+}
+
+// runWithInput is run with stdin answering prompts.
+func runWithInput(t *testing.T, svc *service.Service, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := cli.Run(testutil.MustContext(), svc, nil, args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func mustRun(t *testing.T, svc *service.Service, args ...string) string {
+	t.Helper()
+	code, stdout, stderr := run(t, svc, args...)
+	require.Equal(t, cli.ExitOK, code, "donezo %s: %s", strings.Join(args, " "), stderr)
+	return stdout
+}
+
+func TestRunExitCodes(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStderr string
+	}{
+		{name: "help", args: []string{"help"}, wantCode: cli.ExitOK},
+		{name: "unknown command", args: []string{"boards"}, wantCode: cli.ExitUsage, wantStderr: "unknown command"},
+		{name: "missing subcommand", args: []string{"item"}, wantCode: cli.ExitUsage, wantStderr: "missing command"},
+		{
+			name:       "missing argument",
+			args:       []string{"item", "add", "Inbox"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "usage: donezo item add",
+		},
+		{name: "unknown flag", args: []string{"board", "list", "--all"}, wantCode: cli.ExitUsage},
+		{name: "invalid item id", args: []string{"item", "done", "abc"}, wantCode: cli.ExitUsage},
+		{name: "unknown item", args: []string{"item", "done", "42"}, wantCode: cli.ExitNotFound},
+		{name: "unknown board", args: []string{"board", "rm", "Nope"}, wantCode: cli.ExitNotFound},
+		{name: "unknown tag", args: []string{"tag", "rm", "nope"}, wantCode: cli.ExitNotFound},
+		{
+			name:       "invalid tag query",
+			args:       []string{"item", "list", "--query", "work AND"},
+			wantCode:   cli.ExitError,
+			wantStderr: "unexpected end of query",
+		},
+		{
+			name:       "invalid priority",
+			args:       []string{"item", "add", "Inbox", "task", "--priority", "asap"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "unknown priority",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+			mustRun(t, svc, "board", "add", "Inbox")
+
+			code, _, stderr := run(t, svc, tt.args...)
+			assert.Equal(t, tt.wantCode, code, stderr)
+			assert.Contains(t, stderr, tt.wantStderr)
+		})
+	}
+}
+
+func TestBoardCommands(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+	ctx := testutil.MustContext()
+
+	id := strings.TrimSpace(mustRun(t, svc, "board", "add", "Inbox"))
+	mustRun(t, svc, "board", "add", "Side Projects")
+	assert.Equal(t, "1", id)
+
+	mustRun(t, svc, "board", "rename", "side projects", "Hobbies")
+	mustRun(t, svc, "board", "rm", id)
+
+	boards, err := svc.ListBoards(ctx)
+	require.NoError(t, err)
+	require.Len(t, *boards, 1)
+	assert.Equal(t, "Hobbies", (*boards)[0].Name)
+
+	out := mustRun(t, svc, "board", "list")
+	assert.Contains(t, out, "Hobbies")
+	assert.NotContains(t, out, "Inbox")
+}
+
+func TestItemCommands(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+	ctx := testutil.MustContext()
+
+	mustRun(t, svc, "board", "add", "Inbox")
+	id := strings.TrimSpace(mustRun(
+		t, svc, "item", "add", "Inbox", "Pay rent", "--due", "2026-11-01", "--priority", "high", "--tags", "Finance",
+	))
+	require.Equal(t, "1", id)
+
+	mustRun(t, svc, "item", "tag", id, "home", "Work/Go")
+	mustRun(t, svc, "item", "untag", id, "work/go")
+	mustRun(t, svc, "item", "edit", id, "--title", "Pay the rent", "--due", "")
+	mustRun(t, svc, "item", "done", id)
+	mustRun(t, svc, "item", "done", id)
+
+	item, err := svc.GetItem(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Pay the rent", item.Title)
+	assert.Nil(t, item.DueAt)
+	assert.Equal(t, int64(service.PriorityHigh), item.Priority)
+	assert.True(t, item.Completed)
+	assert.ElementsMatch(t, []string{"finance", "home"}, item.Tags)
+
+	out := mustRun(t, svc, "item", "list", "--tag", "home")
+	assert.Contains(t, out, "Pay the rent")
+	out = mustRun(t, svc, "item", "list", "--query", "home AND NOT finance")
+	assert.NotContains(t, out, "Pay the rent")
+
+	mustRun(t, svc, "item", "undone", id)
+	mustRun(t, svc, "item", "rm", id)
+	code, _, _ := run(t, svc, "item", "done", id)
+	assert.Equal(t, cli.ExitNotFound, code)
+}
+
+func TestQuickAdd(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+		wantItem   string
+		wantBoards []string
+	}{
+		{
+			name:       "line names the board",
+			args:       []string{"add", "Pay rent #finance #home @inbox -- rent for november"},
+			wantStdout: "1\n",
+			wantStderr: `adding "Pay rent" in "Inbox" #finance #home -- rent for november`,
+			wantItem:   "Inbox|Pay rent|rent for november|finance,home",
+			wantBoards: []string{"Inbox"},
+		},
+		{
+			name:       "words are joined and --board is the fallback",
+			args:       []string{"add", "--board", "Inbox", "buy", "milk", "#errands"},
+			wantStdout: "1\n",
+			wantItem:   "Inbox|buy milk||errands",
+			wantBoards: []string{"Inbox"},
+		},
+		{
+			name:       "dry run only prints the preview",
+			args:       []string{"add", "--dry-run", "plan trip @Travel"},
+			wantStdout: "\"plan trip\" in \"Travel\" (new board)\n",
+			wantBoards: []string{"Inbox"},
+		},
+		{
+			name:       "missing board is created when confirmed",
+			args:       []string{"add", "plan trip @Travel"},
+			stdin:      "y\n",
+			wantStdout: "1\n",
+			wantStderr: `board "Travel" does not exist, create it? [y/N]`,
+			wantItem:   "Travel|plan trip||",
+			wantBoards: []string{"Inbox", "Travel"},
+		},
+		{
+			name:       "missing board is created with --yes",
+			args:       []string{"add", "-y", "plan trip @Travel"},
+			wantStdout: "1\n",
+			wantItem:   "Travel|plan trip||",
+			wantBoards: []string{"Inbox", "Travel"},
+		},
+		{
+			name:       "declined board is not found",
+			args:       []string{"add", "plan trip @Travel"},
+			stdin:      "n\n",
+			wantCode:   cli.ExitNotFound,
+			wantStderr: `board "Travel" not found`,
+			wantBoards: []string{"Inbox"},
+		},
+		{
+			name:       "unanswered prompt is no",
+			args:       []string{"add", "plan trip @Travel"},
+			wantCode:   cli.ExitNotFound,
+			wantBoards: []string{"Inbox"},
+		},
+		{
+			name:       "no board",
+			args:       []string{"add", "plan trip"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "no board given",
+			wantBoards: []string{"Inbox"},
+		},
+		{
+			name:       "invalid line",
+			args:       []string{"add", "#trip @Travel"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "item title must not be empty",
+			wantBoards: []string{"Inbox"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+			ctx := testutil.MustContext()
+			mustRun(t, svc, "board", "add", "Inbox")
+
+			code, stdout, stderr := runWithInput(t, svc, tt.stdin, tt.args...)
+			assert.Equal(t, tt.wantCode, code, stderr)
+			if tt.wantStdout != "" {
+				assert.Equal(t, tt.wantStdout, stdout)
+			}
+			assert.Contains(t, stderr, tt.wantStderr)
+
+			boardList, err := svc.ListBoards(ctx)
+			require.NoError(t, err)
+			var names []string
+			for _, b := range *boardList {
+				names = append(names, b.Name)
+			}
+			assert.Equal(t, tt.wantBoards, names)
+
+			item, err := svc.GetItem(ctx, 1)
+			if tt.wantItem == "" {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			board := (*boardList)[slices.IndexFunc(*boardList, func(b service.Board) bool { return b.ID == item.BoardID })]
+			assert.Equal(t, tt.wantItem, strings.Join(
+				[]string{board.Name, item.Title, item.Description, strings.Join(item.Tags, ",")}, "|",
+			))
+		})
+	}
+}
+
+func TestItemDoneRecurring(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	mustRun(t, svc, "board", "add", "Inbox")
+	id := strings.TrimSpace(mustRun(t, svc, "item", "add", "Inbox", "Water plants", "--due", "2026-11-01"))
+	mustRun(t, svc, "item", "edit", id, "--recur", "weekly")
+
+	next := strings.TrimSpace(mustRun(t, svc, "item", "done", id))
+	assert.Equal(t, "2", next)
+}
+
+func TestTagCommands(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+	ctx := testutil.MustContext()
+
+	mustRun(t, svc, "board", "add", "Inbox")
+	mustRun(t, svc, "item", "add", "Inbox", "task", "--tags", "work,home,errands/shop")
+
+	out := mustRun(t, svc, "tag", "list")
+	assert.Contains(t, out, "work")
+
+	mustRun(t, svc, "tag", "rm", "Work")
+	mustRun(t, svc, "tag", "rm", "errands")
+	tags, err := svc.ListTags(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"home"}, tags)
+}
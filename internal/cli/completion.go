@@ -0,0 +1,322 @@
+package cli
+
+import (
+	"context"
+	"embed"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+// CompleteCommand is the hidden command the completion scripts call with the
+// words of the command line being completed, the last one being the word
+// under the cursor. It prints one candidate per line, followed by a tab and
+// a description where there is one.
+const CompleteCommand = "__complete"
+
+//go:embed completion/donezo.*
+var completionScripts embed.FS
+
+// shells are the shells "donezo completion" generates scripts for.
+//
+//nolint:gochecknoglobals // static list of shells
+var shells = []string{"bash", "zsh", "fish"}
+
+// candidate is a completion along with its description.
+type candidate struct {
+	value string
+	desc  string
+}
+
+func (c *CLI) completion(_ context.Context, in *invocation) error {
+	args, err := in.parse(1, 1)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(shells, args[0]) {
+		return &usageError{msg: fmt.Sprintf(
+			"unknown shell %q: expected %s", args[0], strings.Join(shells, ", "),
+		)}
+	}
+	script, err := completionScripts.ReadFile("completion/donezo." + args[0])
+	if err != nil {
+		return err
+	}
+	_, err = c.stdout.Write(script)
+	return err
+}
+
+// complete prints the candidates for the last of words. It is not in the
+// command table since it is no command to show in the usage, and its words
+// are not parsed as flags as they belong to the command being completed.
+func (c *CLI) complete(ctx context.Context, words []string) error {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	current := words[len(words)-1]
+	candidates, err := c.candidates(ctx, words[:len(words)-1], current)
+	if err != nil {
+		return err
+	}
+	// Quotes are ignored so @Side matches @"Side Projects".
+	unquote := strings.NewReplacer(`"`, "").Replace
+	for _, cand := range candidates {
+		if !strings.HasPrefix(unquote(cand.value), unquote(current)) {
+			continue
+		}
+		if cand.desc == "" {
+			fmt.Fprintln(c.stdout, cand.value)
+			continue
+		}
+		fmt.Fprintf(c.stdout, "%s\t%s\n", cand.value, cand.desc)
+	}
+	return nil
+}
+
+// candidates returns the completions of current following the words in done.
+func (c *CLI) candidates(ctx context.Context, done []string, current string) ([]candidate, error) {
+	if len(done) == 0 {
+		var candidates []candidate
+		for _, cmd := range commands {
+			candidates = append(candidates, candidate{value: cmd.name, desc: cmd.summary})
+		}
+		for _, g := range groups {
+			candidates = append(candidates, candidate{value: g.name, desc: g.summary})
+		}
+		return append(candidates, candidate{value: "help", desc: "List every command"}), nil
+	}
+
+	name, cmd, rest, ok := lookupCommand(done)
+	if !ok {
+		if g, found := lookupGroup(done[0]); found && len(done) == 1 {
+			candidates := make([]candidate, 0, len(g.commands))
+			for _, cmd := range g.commands {
+				candidates = append(candidates, candidate{value: cmd.name, desc: cmd.summary})
+			}
+			return candidates, nil
+		}
+		return nil, nil
+	}
+
+	flags := commandFlags(name, cmd)
+	var pending *flag.Flag
+	positional := 0
+	afterFlags := false
+	for _, word := range rest {
+		switch {
+		case pending != nil:
+			pending = nil
+		case afterFlags || word == "-" || !strings.HasPrefix(word, "-"):
+			positional++
+		case word == "--":
+			afterFlags = true
+		default:
+			flagName, _, hasValue := strings.Cut(strings.TrimLeft(word, "-"), "=")
+			if f := flags.Lookup(flagName); f != nil && !hasValue && !isBoolFlag(f) {
+				pending = f
+			}
+		}
+	}
+	switch {
+	case pending != nil:
+		return c.flagValueCandidates(ctx, pending.Name, current)
+	case !afterFlags && strings.HasPrefix(current, "-"):
+		var candidates []candidate
+		flags.VisitAll(func(f *flag.Flag) {
+			prefix := "--"
+			if len(f.Name) == 1 {
+				prefix = "-"
+			}
+			candidates = append(candidates, candidate{value: prefix + f.Name, desc: f.Usage})
+		})
+		return candidates, nil
+	default:
+		return c.argCandidates(ctx, argName(cmd.args, positional), current)
+	}
+}
+
+// lookupCommand finds the command invoked by words and returns its name, as
+// in "board add", along with the words following it.
+func lookupCommand(words []string) (string, command, []string, bool) {
+	for _, cmd := range commands {
+		if cmd.name == words[0] {
+			return cmd.name, cmd, words[1:], true
+		}
+	}
+	g, ok := lookupGroup(words[0])
+	if !ok || len(words) < 2 {
+		return "", command{}, nil, false
+	}
+	for _, cmd := range g.commands {
+		if cmd.name == words[1] {
+			return g.name + " " + cmd.name, cmd, words[2:], true
+		}
+	}
+	return "", command{}, nil, false
+}
+
+func lookupGroup(name string) (group, bool) {
+	for _, g := range groups {
+		if g.name == name {
+			return g, true
+		}
+	}
+	return group{}, false
+}
+
+// commandFlags returns the flags of cmd. Commands declare their flags as
+// they run, so cmd is asked for its help without touching the database.
+func commandFlags(name string, cmd command) *flag.FlagSet {
+	in := newInvocation(name, cmd, []string{"-h"}, io.Discard)
+	_ = cmd.run(&CLI{stdout: io.Discard, stderr: io.Discard}, context.Background(), in)
+	return in.FlagSet
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// argName returns the name of the positional argument at index i in the
+// synopsis args, such as BOARD in "[flags] BOARD TITLE". A trailing "..."
+// repeats the last argument.
+func argName(args string, i int) string {
+	names := slices.DeleteFunc(strings.Fields(args), func(name string) bool {
+		return name == "[flags]"
+	})
+	if len(names) == 0 {
+		return ""
+	}
+	if i < len(names) {
+		return strings.TrimSuffix(names[i], "...")
+	}
+	if last := names[len(names)-1]; strings.HasSuffix(last, "...") {
+		return strings.TrimSuffix(last, "...")
+	}
+	return ""
+}
+
+func (c *CLI) argCandidates(ctx context.Context, arg, current string) ([]candidate, error) {
+	switch {
+	case arg == "BOARD":
+		return c.boardCandidates(ctx, "")
+	case arg == "ID":
+		return c.itemCandidates(ctx)
+	case arg == "TAG":
+		return c.tagCandidates(ctx, "")
+	case arg == "TEXT" && strings.HasPrefix(current, "@"):
+		return c.boardCandidates(ctx, "@")
+	case arg == "TEXT" && strings.HasPrefix(current, "#"):
+		return c.tagCandidates(ctx, "#")
+	case strings.Contains(arg, "|"):
+		return valueCandidates(strings.Split(arg, "|")), nil
+	default:
+		return nil, nil
+	}
+}
+
+func (c *CLI) flagValueCandidates(ctx context.Context, name, current string) ([]candidate, error) {
+	switch name {
+	case "board":
+		return c.boardCandidates(ctx, "")
+	case "tag":
+		return c.tagCandidates(ctx, "")
+	case "tags":
+		// Complete the last of the comma-separated tags.
+		prefix := ""
+		if i := strings.LastIndex(current, ","); i >= 0 {
+			prefix = current[:i+1]
+		}
+		return c.tagCandidates(ctx, prefix)
+	case "priority":
+		var names []string
+		for p := service.PriorityNone; p <= service.PriorityUrgent; p++ {
+			names = append(names, p.String())
+		}
+		return valueCandidates(names), nil
+	case "output", "o":
+		return valueCandidates(formats), nil
+	default:
+		return nil, nil
+	}
+}
+
+func valueCandidates(values []string) []candidate {
+	candidates := make([]candidate, len(values))
+	for i, value := range values {
+		candidates[i] = candidate{value: value}
+	}
+	return candidates
+}
+
+// schemaReady reports whether the database can be read for candidates.
+// Completion never migrates, so a database behind the migrations, or one
+// that cannot be opened, offers no boards, items or tags.
+func (c *CLI) schemaReady(ctx context.Context) bool {
+	if c.migrator == nil {
+		return true
+	}
+	pending, err := c.migrator.Pending(ctx)
+	return err == nil && pending == 0
+}
+
+// boardCandidates returns the board names, each after prefix. Quick-add
+// lines need names with spaces quoted.
+func (c *CLI) boardCandidates(ctx context.Context, prefix string) ([]candidate, error) {
+	if !c.schemaReady(ctx) {
+		return nil, nil
+	}
+	boards, err := c.svc.ListBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(*boards))
+	for i, b := range *boards {
+		name := b.Name
+		if prefix != "" && strings.ContainsFunc(name, func(r rune) bool { return r == ' ' || r == '\t' }) {
+			name = `"` + name + `"`
+		}
+		candidates[i] = candidate{value: prefix + name}
+	}
+	return candidates, nil
+}
+
+func (c *CLI) itemCandidates(ctx context.Context) ([]candidate, error) {
+	if !c.schemaReady(ctx) {
+		return nil, nil
+	}
+	boards, err := c.svc.ListBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items, err := c.listAllItems(ctx, *boards)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(items))
+	for i, item := range items {
+		candidates[i] = candidate{value: strconv.FormatInt(item.ID, 10), desc: item.Title}
+	}
+	return candidates, nil
+}
+
+// tagCandidates returns the tags in use, each after prefix.
+func (c *CLI) tagCandidates(ctx context.Context, prefix string) ([]candidate, error) {
+	if !c.schemaReady(ctx) {
+		return nil, nil
+	}
+	tags, err := c.svc.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(tags))
+	for i, tag := range tags {
+		candidates[i] = candidate{value: prefix + tag}
+	}
+	return candidates, nil
+}
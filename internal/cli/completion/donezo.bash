@@ -0,0 +1,15 @@
+# bash completion for donezo. Load it with
+#
+#   source <(donezo completion bash)
+
+_donezo() {
+    local IFS=$'\n' candidate
+    local -a candidates
+    candidates=($(donezo __complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null))
+    COMPREPLY=()
+    for candidate in "${candidates[@]}"; do
+        COMPREPLY+=("$(printf '%q' "${candidate%%$'\t'*}")")
+    done
+}
+
+complete -o default -F _donezo donezo
@@ -0,0 +1,14 @@
+# fish completion for donezo. Load it with
+#
+#   donezo completion fish | source
+#
+# or save it as donezo.fish in ~/.config/fish/completions.
+
+function __donezo_complete
+    set -l words (commandline -opc)
+    donezo __complete $words[2..-1] (commandline -ct | string collect --allow-empty) 2>/dev/null
+end
+
+complete -c donezo -f -a '(__donezo_complete)'
+complete -c donezo -l db -r -F -d 'Path to the SQLite database'
+complete -c donezo -l config -r -F -d 'Path to the config file'
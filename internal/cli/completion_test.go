@@ -0,0 +1,127 @@
+package cli_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/cli"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestCompletionScripts(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	for shell, want := range map[string]string{
+		"bash": "complete -o default -F _donezo donezo",
+		"zsh":  "compdef _donezo donezo",
+		"fish": "complete -c donezo -f -a '(__donezo_complete)'",
+	} {
+		out := mustRun(t, svc, "completion", shell)
+		assert.Contains(t, out, want, shell)
+		assert.Contains(t, out, "donezo "+cli.CompleteCommand, shell)
+	}
+
+	code, _, stderr := run(t, svc, "completion", "powershell")
+	assert.Equal(t, cli.ExitUsage, code)
+	assert.Contains(t, stderr, `unknown shell "powershell"`)
+}
+
+func TestComplete(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	mustRun(t, svc, "board", "add", "Inbox")
+	mustRun(t, svc, "board", "add", "Side Projects")
+	mustRun(t, svc, "item", "add", "Inbox", "Pay rent", "--tags", "finance,home")
+	mustRun(t, svc, "item", "add", "Side Projects", "Plan trip")
+
+	tests := []struct {
+		name  string
+		words []string
+		want  []string
+	}{
+		{name: "commands", words: []string{"b"}, want: []string{"board\tManage boards"}},
+		{
+			name:  "group commands",
+			words: []string{"board", "r"},
+			want:  []string{"rename\tRename a board", "rm\tMove a board to the trash"},
+		},
+		{name: "board argument", words: []string{"board", "rm", ""}, want: []string{"Inbox", "Side Projects"}},
+		{name: "board argument by prefix", words: []string{"board", "rename", "S"}, want: []string{"Side Projects"}},
+		{name: "second argument has no candidates", words: []string{"board", "rename", "Inbox", ""}},
+		{name: "item ids", words: []string{"item", "done", ""}, want: []string{"1\tPay rent", "2\tPlan trip"}},
+		{name: "tags after the item id", words: []string{"item", "untag", "1", "h"}, want: []string{"home"}},
+		{
+			name:  "flags",
+			words: []string{"item", "list", "--q"},
+			want:  []string{`--query` + "\t" + `only list items matching a tag query such as "work AND NOT later"`},
+		},
+		{name: "flag values", words: []string{"item", "list", "--board", "I"}, want: []string{"Inbox"}},
+		{
+			name:  "flag values skip flags and their values",
+			words: []string{"item", "add", "--desc", "rent", "--priority", "h"},
+			want:  []string{"high"},
+		},
+		{name: "positional after flags", words: []string{"item", "add", "--desc", "x", "I"}, want: []string{"Inbox"}},
+		{
+			name:  "comma-separated tags",
+			words: []string{"item", "edit", "1", "--tags", "home,f"},
+			want:  []string{"home,finance"},
+		},
+		{name: "output formats", words: []string{"tag", "list", "-o", "n"}, want: []string{"ndjson"}},
+		{name: "quick-add boards", words: []string{"add", "buy", "@S"}, want: []string{`@"Side Projects"`}},
+		{name: "quick-add tags", words: []string{"add", "buy", "#f"}, want: []string{"#finance"}},
+		{name: "quick-add words", words: []string{"add", "buy", "m"}},
+		{name: "shells", words: []string{"completion", ""}, want: []string{"bash", "zsh", "fish"}},
+		{name: "unknown command", words: []string{"boards", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := mustRun(t, svc, append([]string{cli.CompleteCommand}, tt.words...)...)
+			var got []string
+			if out != "" {
+				got = strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompleteDoesNotMigrate(t *testing.T) {
+	svc, migrator, cleanup := testutil.NewTestMigrator(t)
+	defer cleanup()
+	ctx := testutil.MustContext()
+
+	var stdout, stderr bytes.Buffer
+	for _, words := range [][]string{{"board", "rm", ""}, {"item", "done", ""}, {"add", "#"}} {
+		args := append([]string{cli.CompleteCommand}, words...)
+		code := cli.Run(ctx, svc, migrator, args, strings.NewReader(""), &stdout, &stderr)
+		require.Equal(t, cli.ExitOK, code, stderr.String())
+	}
+	assert.Empty(t, stdout.String())
+
+	code := cli.Run(ctx, svc, migrator, []string{cli.CompleteCommand, "boa"}, strings.NewReader(""), &stdout, &stderr)
+	require.Equal(t, cli.ExitOK, code, stderr.String())
+	assert.Equal(t, "board\tManage boards\n", stdout.String())
+
+	migrations, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	pending, err := migrator.Pending(ctx)
+	require.NoError(t, err)
+	assert.Len(t, migrations, pending)
+	assert.True(t, cli.IsCompletion([]string{cli.CompleteCommand, "board"}))
+	assert.False(t, cli.IsCompletion([]string{"completion", "bash"}))
+}
+
+func TestCompleteIsNotACommand(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	require.NotContains(t, mustRun(t, svc, "help"), cli.CompleteCommand)
+}
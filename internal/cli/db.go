@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rhajizada/donezo/internal/migrate"
+)
+
+//nolint:gochecknoglobals // static command table
+var dbCommands = []command{
+	{name: "status", args: "[flags]", summary: "List migrations and whether they are applied", run: (*CLI).dbStatus},
+	{name: "version", args: "", summary: "Print the schema version of the database", run: (*CLI).dbVersion},
+	{name: "up", args: "", summary: "Apply every pending migration", run: (*CLI).dbUp},
+	{name: "down", args: "", summary: "Roll back the latest migration", run: (*CLI).dbDown},
+	{name: "redo", args: "", summary: "Roll back the latest migration and apply it again", run: (*CLI).dbRedo},
+}
+
+//nolint:gochecknoglobals // static output schema
+var migrationSchema = schema[migrate.Migration]{
+	fields: []field[migrate.Migration]{
+		{name: "version", value: func(m migrate.Migration) any { return m.Version }},
+		{name: "name", value: func(m migrate.Migration) any { return m.Name }},
+		{name: "state", value: func(m migrate.Migration) any { return migrationState(m) }},
+		{name: "appliedAt", value: func(m migrate.Migration) any { return appliedAt(m) }},
+	},
+	table: []string{"version", "name", "state", "appliedAt"},
+}
+
+func migrationState(m migrate.Migration) string {
+	if m.Applied {
+		return "applied"
+	}
+	return "pending"
+}
+
+func appliedAt(m migrate.Migration) *time.Time {
+	if !m.Applied {
+		return nil
+	}
+	return &m.AppliedAt
+}
+
+func (c *CLI) dbStatus(ctx context.Context, in *invocation) error {
+	opts := in.outputFlags()
+	if _, err := in.parse(0, 0); err != nil {
+		return err
+	}
+	if err := opts.validate(migrationSchema.names()); err != nil {
+		return err
+	}
+	migrations, err := c.migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+	return writeRecords(c.stdout, migrationSchema, opts, migrations)
+}
+
+// dbVersion prints the version of the database, and on stderr how far it is
+// behind the latest migration.
+func (c *CLI) dbVersion(ctx context.Context, in *invocation) error {
+	if _, err := in.parse(0, 0); err != nil {
+		return err
+	}
+	current, latest, err := c.migrator.Version(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout, current)
+	if pending, pendingErr := c.migrator.Pending(ctx); pendingErr == nil && pending > 0 {
+		fmt.Fprintf(c.stderr, "%d pending migrations, the latest version is %d\n", pending, latest)
+	}
+	return nil
+}
+
+func (c *CLI) dbUp(ctx context.Context, in *invocation) error {
+	if _, err := in.parse(0, 0); err != nil {
+		return err
+	}
+	results, err := c.migrator.Up(ctx)
+	c.printBackup()
+	for _, r := range results {
+		fmt.Fprintf(c.stdout, "applied %s\n", r.Name)
+	}
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		fmt.Fprintln(c.stderr, "no pending migrations")
+	}
+	return nil
+}
+
+func (c *CLI) dbDown(ctx context.Context, in *invocation) error {
+	if _, err := in.parse(0, 0); err != nil {
+		return err
+	}
+	result, err := c.migrator.Down(ctx)
+	c.printBackup()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, "rolled back %s\n", result.Name)
+	return nil
+}
+
+func (c *CLI) dbRedo(ctx context.Context, in *invocation) error {
+	if _, err := in.parse(0, 0); err != nil {
+		return err
+	}
+	result, err := c.migrator.Redo(ctx)
+	c.printBackup()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, "reapplied %s\n", result.Name)
+	return nil
+}
+
+// printBackup tells where the database was saved before a schema change.
+func (c *CLI) printBackup() {
+	if c.migrator.Backup != "" {
+		fmt.Fprintf(c.stderr, "backed up database to %s\n", c.migrator.Backup)
+	}
+}
@@ -0,0 +1,77 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/cli"
+	"github.com/rhajizada/donezo/internal/migrate"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func runDB(t *testing.T, migrator *migrate.Migrator, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := cli.Run(testutil.MustContext(), nil, migrator, append([]string{"db"}, args...), nil, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestDBCommands(t *testing.T) {
+	_, migrator, cleanup := testutil.NewTestMigrator(t)
+	defer cleanup()
+
+	code, stdout, stderr := runDB(t, migrator, "version")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "0\n", stdout)
+	assert.Contains(t, stderr, "pending migrations")
+
+	code, stdout, stderr = runDB(t, migrator, "status", "-o", "json")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	var statuses []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(stdout), &statuses))
+	require.NotEmpty(t, statuses)
+	assert.Equal(t, map[string]any{
+		"version":   float64(1),
+		"name":      "00001_initial_schema.sql",
+		"state":     "pending",
+		"appliedAt": nil,
+	}, statuses[0])
+	latest := statuses[len(statuses)-1]["name"].(string)
+
+	code, stdout, stderr = runDB(t, migrator, "up")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, len(statuses), strings.Count(stdout, "applied "))
+	assert.NotContains(t, stderr, "backed up", "an empty database is not backed up")
+
+	code, _, stderr = runDB(t, migrator, "up")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Contains(t, stderr, "no pending migrations")
+
+	code, stdout, stderr = runDB(t, migrator, "down")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "rolled back "+latest+"\n", stdout)
+	assert.Contains(t, stderr, "backed up database to ")
+
+	code, stdout, stderr = runDB(t, migrator, "status")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Contains(t, stdout, "VERSION")
+	assert.Equal(t, 1, strings.Count(stdout, "pending"))
+
+	code, stdout, stderr = runDB(t, migrator, "redo")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Contains(t, stdout, "reapplied ")
+
+	code, _, _ = runDB(t, migrator, "version", "extra")
+	assert.Equal(t, cli.ExitUsage, code)
+}
+
+func TestManagesSchema(t *testing.T) {
+	assert.True(t, cli.ManagesSchema([]string{"db", "status"}))
+	assert.False(t, cli.ManagesSchema([]string{"board", "list"}))
+	assert.False(t, cli.ManagesSchema(nil))
+}
@@ -0,0 +1,329 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/helpers"
+)
+
+//nolint:gochecknoglobals // static command table
+var itemCommands = []command{
+	{
+		name:    "list",
+		args:    "[flags]",
+		summary: "List items of every board, a board, a tag or a tag query",
+		run:     (*CLI).itemList,
+	},
+	{name: "add", args: "[flags] BOARD TITLE", summary: "Create an item and print its id", run: (*CLI).itemAdd},
+	{name: "edit", args: "[flags] ID", summary: "Change the fields of an item", run: (*CLI).itemEdit},
+	{name: "done", args: "ID", summary: "Mark an item as completed", run: (*CLI).itemDone},
+	{name: "undone", args: "ID", summary: "Mark an item as not completed", run: (*CLI).itemUndone},
+	{name: "rm", args: "ID", summary: "Move an item to the trash", run: (*CLI).itemRemove},
+	{name: "tag", args: "ID TAG...", summary: "Add tags to an item", run: (*CLI).itemTag},
+	{name: "untag", args: "ID TAG...", summary: "Remove tags from an item", run: (*CLI).itemUntag},
+}
+
+// itemRecord is an item along with the name of its board.
+type itemRecord struct {
+	service.Item
+
+	board string
+}
+
+//nolint:gochecknoglobals // static output schema
+var itemSchema = schema[itemRecord]{
+	fields: []field[itemRecord]{
+		{name: "id", value: func(i itemRecord) any { return i.ID }},
+		{name: "boardId", value: func(i itemRecord) any { return i.BoardID }},
+		{name: "board", value: func(i itemRecord) any { return i.board }},
+		{name: "title", value: func(i itemRecord) any { return i.Title }},
+		{name: "description", value: func(i itemRecord) any { return i.Description }},
+		{name: "completed", value: func(i itemRecord) any { return i.Completed }},
+		{name: "priority", value: func(i itemRecord) any { return service.Priority(i.Priority).String() }},
+		{name: "dueAt", value: func(i itemRecord) any { return dueDate(i.DueAt) }},
+		{name: "recurrence", value: func(i itemRecord) any { return i.Recurrence }},
+		{name: "tags", value: func(i itemRecord) any { return i.Tags }},
+		{name: "subtasksTotal", value: func(i itemRecord) any { return i.SubtasksTotal }},
+		{name: "subtasksDone", value: func(i itemRecord) any { return i.SubtasksDone }},
+		{name: "blockedBy", value: func(i itemRecord) any { return i.BlockedBy }},
+		{name: "createdAt", value: func(i itemRecord) any { return i.CreatedAt }},
+		{name: "lastUpdatedAt", value: func(i itemRecord) any { return i.LastUpdatedAt }},
+	},
+	table: []string{"id", "board", "completed", "priority", "title", "tags", "dueAt"},
+}
+
+// dueDate formats a due date as a calendar day, or nil without one.
+func dueDate(due *time.Time) *string {
+	if due == nil {
+		return nil
+	}
+	day := helpers.FormatDueDate(due)
+	return &day
+}
+
+func (c *CLI) itemList(ctx context.Context, in *invocation) error {
+	boardRef := in.String("board", "", "only list items of this board")
+	tag := in.String("tag", "", "only list items with this tag or a tag below it")
+	query := in.String("query", "", "only list items matching a tag query such as \"work AND NOT later\"")
+	opts := in.outputFlags()
+	if _, err := in.parse(0, 0); err != nil {
+		return err
+	}
+	set := in.visited()
+	if (set["board"] && set["tag"]) || (set["board"] && set["query"]) || (set["tag"] && set["query"]) {
+		return &usageError{msg: "--board, --tag and --query cannot be combined"}
+	}
+	if err := opts.validate(itemSchema.names()); err != nil {
+		return err
+	}
+
+	boards, err := c.svc.ListBoards(ctx)
+	if err != nil {
+		return err
+	}
+	var items []service.Item
+	switch {
+	case *boardRef != "":
+		board, resolveErr := c.resolveBoard(ctx, *boardRef)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		items, err = deref(c.svc.ListItemsByBoard(ctx, board))
+	case *tag != "":
+		normalized, tagErr := service.NormalizeTag(*tag)
+		if tagErr != nil {
+			return &usageError{msg: tagErr.Error()}
+		}
+		items, err = deref(c.svc.ListItemsByTag(ctx, normalized))
+	case *query != "":
+		items, err = deref(c.svc.ListItemsByTagQuery(ctx, *query))
+	default:
+		items, err = c.listAllItems(ctx, *boards)
+	}
+	if err != nil {
+		return err
+	}
+
+	names := make(map[int64]string, len(*boards))
+	for _, b := range *boards {
+		names[b.ID] = b.Name
+	}
+	records := make([]itemRecord, len(items))
+	for i, item := range items {
+		records[i] = itemRecord{Item: item, board: names[item.BoardID]}
+	}
+	return writeRecords(c.stdout, itemSchema, opts, records)
+}
+
+// listAllItems returns the items of every board in boards.
+func (c *CLI) listAllItems(ctx context.Context, boards []service.Board) ([]service.Item, error) {
+	var items []service.Item
+	for i := range boards {
+		boardItems, err := deref(c.svc.ListItemsByBoard(ctx, &boards[i]))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, boardItems...)
+	}
+	return items, nil
+}
+
+func deref[T any](v *[]T, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return *v, nil
+}
+
+func (c *CLI) itemAdd(ctx context.Context, in *invocation) error {
+	desc := in.String("desc", "", "description")
+	due := in.String("due", "", "due date as "+helpers.DueDateLayout)
+	priority := in.String("priority", "", "none, low, medium, high or urgent")
+	tags := in.String("tags", "", "comma-separated tags, added to the board's default tags")
+	args, err := in.parse(2, 2)
+	if err != nil {
+		return err
+	}
+	title := strings.TrimSpace(args[1])
+	if title == "" {
+		return &usageError{msg: "item title must not be empty"}
+	}
+	dueAt, err := helpers.ParseDueDate(*due)
+	if err != nil {
+		return &usageError{msg: err.Error()}
+	}
+	level, err := service.ParsePriority(*priority)
+	if *priority != "" && err != nil {
+		return &usageError{msg: err.Error()}
+	}
+	extra, err := helpers.ExtractTags(*tags)
+	if err != nil {
+		return &usageError{msg: err.Error()}
+	}
+
+	board, err := c.resolveBoard(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	item := &service.Item{Tags: extra}
+	item.Title, item.Description, item.DueAt, item.Priority = title, *desc, dueAt, int64(level)
+	if item, err = c.svc.CreateItemFrom(ctx, board, item); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout, item.ID)
+	return nil
+}
+
+func (c *CLI) itemEdit(ctx context.Context, in *invocation) error {
+	title := in.String("title", "", "new title")
+	desc := in.String("desc", "", "new description")
+	due := in.String("due", "", "new due date as "+helpers.DueDateLayout+", empty to clear")
+	priority := in.String("priority", "", "none, low, medium, high or urgent")
+	recurrence := in.String("recur", "", "recurrence rule such as \"weekly\", empty to clear")
+	tags := in.String("tags", "", "comma-separated tags replacing the current ones")
+	args, err := in.parse(1, 1)
+	if err != nil {
+		return err
+	}
+	set := in.visited()
+	if len(set) == 0 {
+		return &usageError{msg: "nothing to change, see \"" + in.synopsis + " -h\""}
+	}
+	item, err := c.getItem(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if set["title"] {
+		if strings.TrimSpace(*title) == "" {
+			return &usageError{msg: "item title must not be empty"}
+		}
+		item.Title = strings.TrimSpace(*title)
+	}
+	if set["desc"] {
+		item.Description = *desc
+	}
+	if set["due"] {
+		if item.DueAt, err = helpers.ParseDueDate(*due); err != nil {
+			return &usageError{msg: err.Error()}
+		}
+	}
+	if set["priority"] {
+		level, parseErr := service.ParsePriority(*priority)
+		if parseErr != nil {
+			return &usageError{msg: parseErr.Error()}
+		}
+		item.Priority = int64(level)
+	}
+	if set["recur"] {
+		rule, parseErr := service.ParseRecurrence(*recurrence)
+		if parseErr != nil {
+			return &usageError{msg: parseErr.Error()}
+		}
+		item.Recurrence = rule.String()
+	}
+	if set["tags"] {
+		if item.Tags, err = helpers.ExtractTags(*tags); err != nil {
+			return &usageError{msg: err.Error()}
+		}
+	}
+	_, err = c.svc.UpdateItem(ctx, item)
+	return err
+}
+
+func (c *CLI) itemDone(ctx context.Context, in *invocation) error {
+	return c.setCompleted(ctx, in, true)
+}
+
+func (c *CLI) itemUndone(ctx context.Context, in *invocation) error {
+	return c.setCompleted(ctx, in, false)
+}
+
+// setCompleted toggles an item unless it already is in the requested state.
+// Completing a recurring item prints the id of its next occurrence.
+func (c *CLI) setCompleted(ctx context.Context, in *invocation, completed bool) error {
+	args, err := in.parse(1, 1)
+	if err != nil {
+		return err
+	}
+	item, err := c.getItem(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if item.Completed == completed {
+		return nil
+	}
+	_, next, err := c.svc.ToggleItem(ctx, item)
+	if err != nil {
+		return err
+	}
+	if next != nil {
+		fmt.Fprintln(c.stdout, next.ID)
+	}
+	return nil
+}
+
+func (c *CLI) itemRemove(ctx context.Context, in *invocation) error {
+	args, err := in.parse(1, 1)
+	if err != nil {
+		return err
+	}
+	item, err := c.getItem(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return c.svc.DeleteItem(ctx, item)
+}
+
+func (c *CLI) itemTag(ctx context.Context, in *invocation) error {
+	return c.editTags(ctx, in, func(tags, changes []string) []string {
+		return append(tags, changes...)
+	})
+}
+
+func (c *CLI) itemUntag(ctx context.Context, in *invocation) error {
+	return c.editTags(ctx, in, func(tags, changes []string) []string {
+		return slices.DeleteFunc(tags, func(tag string) bool {
+			return slices.Contains(changes, tag)
+		})
+	})
+}
+
+func (c *CLI) editTags(ctx context.Context, in *invocation, apply func(tags, changes []string) []string) error {
+	args, err := in.parse(2, -1)
+	if err != nil {
+		return err
+	}
+	changes, err := service.NormalizeTags(args[1:])
+	if err != nil {
+		return &usageError{msg: err.Error()}
+	}
+	item, err := c.getItem(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	item.Tags = apply(item.Tags, changes)
+	_, err = c.svc.UpdateItem(ctx, item)
+	return err
+}
+
+// getItem looks up an item by its id.
+func (c *CLI) getItem(ctx context.Context, ref string) (*service.Item, error) {
+	id, err := strconv.ParseInt(ref, 10, 64)
+	if err != nil {
+		return nil, &usageError{msg: fmt.Sprintf("invalid item id %q", ref)}
+	}
+	item, err := c.svc.GetItem(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &notFoundError{kind: "item", ref: ref}
+	}
+	return item, err
+}
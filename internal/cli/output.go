@@ -0,0 +1,275 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
+)
+
+// Output formats accepted by --output.
+const (
+	FormatTable  = "table"
+	FormatJSON   = "json"
+	FormatNDJSON = "ndjson"
+	FormatCSV    = "csv"
+)
+
+//nolint:gochecknoglobals // static list of formats
+var formats = []string{FormatTable, FormatJSON, FormatNDJSON, FormatCSV}
+
+// tableTimeLayout is how timestamps are shown in tables; every other format
+// uses RFC 3339.
+const tableTimeLayout = "2006-01-02 15:04"
+
+// field is a named column of a listing. value returns an int64, bool, string,
+// []string, time.Time or a nil-able pointer to a string or time.Time.
+type field[T any] struct {
+	name  string
+	value func(T) any
+}
+
+// schema lists the fields of a record kind in output order, along with the
+// subset shown in tables by default.
+type schema[T any] struct {
+	fields []field[T]
+	table  []string
+}
+
+// outputOptions holds the --output and --fields flags of a listing command.
+type outputOptions struct {
+	format string
+	fields string
+}
+
+func (in *invocation) outputFlags() *outputOptions {
+	opts := &outputOptions{}
+	in.StringVar(&opts.format, "output", FormatTable, "output format: "+strings.Join(formats, ", "))
+	in.StringVar(&opts.format, "o", FormatTable, "shorthand for --output")
+	in.StringVar(&opts.fields, "fields", "", "comma-separated fields to include, in order")
+	return opts
+}
+
+// validate checks the flags before anything is read from the database.
+func (o *outputOptions) validate(names []string) error {
+	if !slices.Contains(formats, o.format) {
+		return &usageError{msg: fmt.Sprintf(
+			"unknown output format %q: expected %s", o.format, strings.Join(formats, ", "),
+		)}
+	}
+	if o.fields == "" {
+		return nil
+	}
+	for _, name := range strings.Split(o.fields, ",") {
+		if !slices.Contains(names, strings.TrimSpace(name)) {
+			return &usageError{msg: fmt.Sprintf(
+				"unknown field %q: expected %s", strings.TrimSpace(name), strings.Join(names, ", "),
+			)}
+		}
+	}
+	return nil
+}
+
+func (s schema[T]) names() []string {
+	names := make([]string, len(s.fields))
+	for i, f := range s.fields {
+		names[i] = f.name
+	}
+	return names
+}
+
+// selected returns the fields requested with --fields, or else every field,
+// except for tables which default to a compact subset.
+func (s schema[T]) selected(opts *outputOptions) []field[T] {
+	names := s.table
+	switch {
+	case opts.fields != "":
+		names = strings.Split(opts.fields, ",")
+	case opts.format != FormatTable:
+		return s.fields
+	}
+	fields := make([]field[T], 0, len(names))
+	for _, name := range names {
+		idx := slices.IndexFunc(s.fields, func(f field[T]) bool { return f.name == strings.TrimSpace(name) })
+		fields = append(fields, s.fields[idx])
+	}
+	return fields
+}
+
+// writeRecords writes records in the format chosen by opts.
+func writeRecords[T any](w io.Writer, s schema[T], opts *outputOptions, records []T) error {
+	fields := s.selected(opts)
+	switch opts.format {
+	case FormatJSON:
+		var buf bytes.Buffer
+		buf.WriteString("[")
+		for i, r := range records {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n  ")
+			if err := writeObject(&buf, fields, r); err != nil {
+				return err
+			}
+		}
+		if len(records) > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString("]\n")
+		_, err := w.Write(buf.Bytes())
+		return err
+	case FormatNDJSON:
+		var buf bytes.Buffer
+		for _, r := range records {
+			if err := writeObject(&buf, fields, r); err != nil {
+				return err
+			}
+			buf.WriteString("\n")
+		}
+		_, err := w.Write(buf.Bytes())
+		return err
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		header := make([]string, len(fields))
+		for i, f := range fields {
+			header[i] = f.name
+		}
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+		for _, r := range records {
+			row := make([]string, len(fields))
+			for i, f := range fields {
+				row[i] = formatCell(f.value(r), time.RFC3339, "true", "false")
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		header := make([]string, len(fields))
+		for i, f := range fields {
+			header[i] = columnHeader(f.name)
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+			return err
+		}
+		for _, r := range records {
+			row := make([]string, len(fields))
+			for i, f := range fields {
+				row[i] = formatCell(f.value(r), tableTimeLayout, "x", "")
+			}
+			if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+				return err
+			}
+		}
+		return tw.Flush()
+	}
+}
+
+// writeObject writes one record as a JSON object with fields in order.
+func writeObject[T any](buf *bytes.Buffer, fields []field[T], record T) error {
+	buf.WriteString("{")
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		key, err := json.Marshal(f.name)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(jsonValue(f.value(record)))
+		if err != nil {
+			return err
+		}
+		buf.Write(key)
+		buf.WriteString(":")
+		buf.Write(value)
+	}
+	buf.WriteString("}")
+	return nil
+}
+
+// jsonValue keeps tags as arrays, even when empty, and writes timestamps as
+// RFC 3339 in UTC.
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case []string:
+		if v == nil {
+			return []string{}
+		}
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return v
+	}
+}
+
+// formatCell renders a value for tables and CSV, where lists are joined with
+// commas and missing values are empty.
+func formatCell(v any, timeLayout, yes, no string) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return *v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		if v {
+			return yes
+		}
+		return no
+	case []string:
+		return strings.Join(v, ",")
+	case time.Time:
+		return formatTime(v, timeLayout)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return formatTime(*v, timeLayout)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatTime(t time.Time, layout string) string {
+	if layout == time.RFC3339 {
+		return t.UTC().Format(layout)
+	}
+	return t.In(time.Local).Format(layout)
+}
+
+// columnHeader turns a field name such as "dueAt" into "DUE AT".
+func columnHeader(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
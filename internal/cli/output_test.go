@@ -0,0 +1,86 @@
+package cli_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/cli"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestListOutputFormats(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	mustRun(t, svc, "board", "add", "Inbox")
+	mustRun(t, svc, "item", "add", "Inbox", "Pay rent", "--due", "2026-11-01", "--tags", "finance,home")
+	mustRun(t, svc, "item", "add", "Inbox", "Call mom")
+
+	t.Run("json keeps fields in schema order and tags as arrays", func(t *testing.T) {
+		out := mustRun(t, svc, "item", "list", "--output", "json")
+		var items []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(out), &items))
+		require.Len(t, items, 2)
+		assert.Equal(t, []any{"finance", "home"}, items[0]["tags"])
+		assert.Equal(t, []any{}, items[1]["tags"])
+		assert.Equal(t, "2026-11-01", items[0]["dueAt"])
+		assert.Nil(t, items[1]["dueAt"])
+		assert.Equal(t, "Inbox", items[0]["board"])
+		assert.Less(t, strings.Index(out, `"id"`), strings.Index(out, `"title"`))
+	})
+
+	t.Run("ndjson writes one object per line", func(t *testing.T) {
+		out := mustRun(t, svc, "item", "list", "-o", "ndjson", "--fields", "id,tags")
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		assert.Equal(t, []string{`{"id":1,"tags":["finance","home"]}`, `{"id":2,"tags":[]}`}, lines)
+	})
+
+	t.Run("csv has a header and joins tags", func(t *testing.T) {
+		out := mustRun(t, svc, "item", "list", "--output", "csv", "--fields", "title,tags,completed")
+		records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"title", "tags", "completed"},
+			{"Pay rent", "finance,home", "false"},
+			{"Call mom", "", "false"},
+		}, records)
+	})
+
+	t.Run("table uses column headers", func(t *testing.T) {
+		out := mustRun(t, svc, "tag", "list", "--fields", "tag,total")
+		assert.Equal(t, "TAG      TOTAL\nfinance  1\nhome     1\n", out)
+	})
+
+	t.Run("boards", func(t *testing.T) {
+		out := mustRun(t, svc, "board", "list", "--output", "ndjson", "--fields", "id,name,defaultTags")
+		assert.Equal(t, "{\"id\":1,\"name\":\"Inbox\",\"defaultTags\":[]}\n", out)
+	})
+}
+
+func TestListOutputErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantStderr string
+	}{
+		{name: "unknown format", args: []string{"item", "list", "--output", "yaml"}, wantStderr: "unknown output format"},
+		{name: "unknown field", args: []string{"board", "list", "--fields", "id,color"}, wantStderr: "unknown field \"color\""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			code, stdout, stderr := run(t, svc, tt.args...)
+			assert.Equal(t, cli.ExitUsage, code)
+			assert.Empty(t, stdout)
+			assert.Contains(t, stderr, tt.wantStderr)
+		})
+	}
+}
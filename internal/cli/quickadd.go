@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+func (c *CLI) quickAdd(ctx context.Context, in *invocation) error {
+	boardRef := in.String("board", "", "board to use when the line names none with @BOARD")
+	yes := in.Bool("yes", false, "create a missing board without asking")
+	in.BoolVar(yes, "y", false, "shorthand for --yes")
+	dryRun := in.Bool("dry-run", false, "print the parsed item without saving it")
+	args, err := in.parse(1, -1)
+	if err != nil {
+		return err
+	}
+	q, err := service.ParseQuickAdd(strings.Join(args, " "))
+	if err != nil {
+		return &usageError{msg: err.Error()}
+	}
+	ref := q.Board
+	if ref == "" {
+		ref = *boardRef
+	}
+	if ref == "" {
+		return &usageError{msg: "no board given, name one with @BOARD or --board"}
+	}
+
+	board, err := c.resolveBoard(ctx, ref)
+	var notFound *notFoundError
+	missing := errors.As(err, &notFound)
+	if err != nil && !missing {
+		return err
+	}
+	q.Board = ref
+	if board != nil {
+		q.Board = board.Name
+	}
+	preview := q.String()
+	if missing {
+		preview += " (new board)"
+	}
+	if *dryRun {
+		fmt.Fprintln(c.stdout, preview)
+		return nil
+	}
+	fmt.Fprintln(c.stderr, "adding", preview)
+
+	if missing {
+		if !*yes && !c.confirm(fmt.Sprintf("board %q does not exist, create it?", ref)) {
+			return err
+		}
+		if board, err = c.svc.CreateBoard(ctx, ref); err != nil {
+			return err
+		}
+	}
+	item, err := c.svc.CreateQuickAdd(ctx, board, q)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout, item.ID)
+	return nil
+}
+
+// confirm asks question on stderr and reports whether the answer read from
+// stdin is yes. Without an answer it is no.
+func (c *CLI) confirm(question string) bool {
+	fmt.Fprintf(c.stderr, "%s [y/N] ", question)
+	if c.stdin == nil {
+		fmt.Fprintln(c.stderr)
+		return false
+	}
+	answer, err := bufio.NewReader(c.stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(c.stderr)
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
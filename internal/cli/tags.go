@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+//nolint:gochecknoglobals // static command table
+var tagCommands = []command{
+	{name: "list", args: "[flags]", summary: "List tags with their open and done items", run: (*CLI).tagList},
+	{name: "rm", args: "TAG", summary: "Remove a tag and the tags below it from every item", run: (*CLI).tagRemove},
+}
+
+//nolint:gochecknoglobals // static output schema
+var tagSchema = schema[service.TagSummary]{
+	fields: []field[service.TagSummary]{
+		{name: "tag", value: func(t service.TagSummary) any { return t.Tag }},
+		{name: "open", value: func(t service.TagSummary) any { return t.Open }},
+		{name: "done", value: func(t service.TagSummary) any { return t.Done }},
+		{name: "total", value: func(t service.TagSummary) any { return t.Total() }},
+		{name: "lastActivityAt", value: func(t service.TagSummary) any { return t.LastActivityAt }},
+	},
+	table: []string{"tag", "open", "done", "lastActivityAt"},
+}
+
+func (c *CLI) tagList(ctx context.Context, in *invocation) error {
+	opts := in.outputFlags()
+	if _, err := in.parse(0, 0); err != nil {
+		return err
+	}
+	if err := opts.validate(tagSchema.names()); err != nil {
+		return err
+	}
+	summaries, err := c.svc.ListTagSummaries(ctx)
+	if err != nil {
+		return err
+	}
+	return writeRecords(c.stdout, tagSchema, opts, summaries)
+}
+
+func (c *CLI) tagRemove(ctx context.Context, in *invocation) error {
+	args, err := in.parse(1, 1)
+	if err != nil {
+		return err
+	}
+	tag, err := service.NormalizeTag(args[0])
+	if err != nil {
+		return &usageError{msg: err.Error()}
+	}
+	tags, err := c.svc.ListTags(ctx)
+	if err != nil {
+		return err
+	}
+	inUse := slices.ContainsFunc(tags, func(t string) bool {
+		return t == tag || strings.HasPrefix(t, tag+service.TagPathSeparator)
+	})
+	if !inUse {
+		return &notFoundError{kind: "tag", ref: tag}
+	}
+	return c.svc.DeleteTag(ctx, tag)
+}
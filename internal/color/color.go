@@ -0,0 +1,21 @@
+// Package color validates the colors donezo accepts in its config and for
+// tags.
+package color
+
+import (
+	"strconv"
+	"strings"
+)
+
+const maxANSI = 255
+
+// Valid reports whether value is "#RGB", "#RRGGBB" or an ANSI color number
+// from 0 to 255.
+func Valid(value string) bool {
+	if hex, ok := strings.CutPrefix(value, "#"); ok {
+		_, err := strconv.ParseUint(hex, 16, 32)
+		return err == nil && (len(hex) == 3 || len(hex) == 6)
+	}
+	n, err := strconv.Atoi(value)
+	return err == nil && n >= 0 && n <= maxANSI
+}
@@ -0,0 +1,34 @@
+package color_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rhajizada/donezo/internal/color"
+)
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "#fff", want: true},
+		{value: "#7D56F4", want: true},
+		{value: "0", want: true},
+		{value: "255", want: true},
+		{value: ""},
+		{value: "#ffff"},
+		{value: "#ggg"},
+		{value: "#+ff"},
+		{value: "256"},
+		{value: "-1"},
+		{value: "red"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, color.Valid(tt.value))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Package config loads the donezo config file.
+//
+// The file is YAML and every setting is optional:
+//
+//	db: ~/notes/donezo.db
+//	defaultBoard: Inbox
+//	hideCompleted: true
+//	clipboard: osc52
+//	colors:
+//	  accent: "#7D56F4"
+//	  error: "9"
+//	keys:
+//	  boards:
+//	    createBoard: [n]
+//	  list:
+//	    toggleHide: [H]
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rhajizada/donezo/internal/color"
+	"github.com/rhajizada/donezo/internal/paths"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+// Config holds the settings of the config file.
+type Config struct {
+	// DB is the database to open unless --db or DONEZO_DB is set.
+	DB string `yaml:"db"`
+	// DefaultBoard is the name of the board the TUI opens at startup.
+	DefaultBoard string `yaml:"defaultBoard"`
+	// HideCompleted hides completed items when a board or tag is opened.
+	HideCompleted bool `yaml:"hideCompleted"`
+	// Clipboard is the clipboard backend of the TUI, auto when empty.
+	Clipboard string `yaml:"clipboard"`
+	// Colors overrides the colors of the TUI.
+	Colors Colors `yaml:"colors"`
+	// Keys overrides keybindings by view and action.
+	Keys map[string]keybindings.Overrides `yaml:"keys"`
+}
+
+// Colors are hex values such as "#7D56F4" or ANSI color numbers from 0 to
+// 255. Empty colors keep their default.
+type Colors struct {
+	Accent   string `yaml:"accent"`
+	Status   string `yaml:"status"`
+	Error    string `yaml:"error"`
+	Overdue  string `yaml:"overdue"`
+	DueToday string `yaml:"dueToday"`
+	Muted    string `yaml:"muted"`
+}
+
+// Palette returns the colors as a styles.Palette.
+func (c Colors) Palette() styles.Palette {
+	return styles.Palette{
+		Accent:   c.Accent,
+		Status:   c.Status,
+		Error:    c.Error,
+		Overdue:  c.Overdue,
+		DueToday: c.DueToday,
+		Muted:    c.Muted,
+	}
+}
+
+// Load reads the config file at path. A missing file yields the defaults.
+// Errors name the file and, where possible, the offending line or setting.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	var cfg Config
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	if err = cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+func (c *Config) validate() error {
+	var errs []error
+	if c.DB != "" {
+		db, err := paths.Expand(c.DB)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("db: %w", err))
+		}
+		c.DB = db
+	}
+	c.DefaultBoard = strings.TrimSpace(c.DefaultBoard)
+	if !clipboard.ValidName(c.Clipboard) {
+		errs = append(errs, fmt.Errorf(
+			"clipboard: unknown backend %q, expected %s", c.Clipboard, strings.Join(clipboard.Backends, ", "),
+		))
+	}
+	for _, setting := range []struct {
+		name  string
+		value string
+	}{
+		{"accent", c.Colors.Accent},
+		{"status", c.Colors.Status},
+		{"error", c.Colors.Error},
+		{"overdue", c.Colors.Overdue},
+		{"dueToday", c.Colors.DueToday},
+		{"muted", c.Colors.Muted},
+	} {
+		if setting.value != "" && !color.Valid(setting.value) {
+			errs = append(errs, fmt.Errorf(
+				"colors.%s: invalid color %q, expected #RGB, #RRGGBB or an ANSI color number from 0 to 255",
+				setting.name, setting.value,
+			))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,109 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/config"
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	tests := []struct {
+		name    string
+		content string
+		want    config.Config
+		wantErr string
+	}{
+		{name: "empty file", content: "", want: config.Config{}},
+		{
+			name: "every setting",
+			content: `db: ~/tasks.db
+defaultBoard: " Inbox "
+hideCompleted: true
+clipboard: register
+colors:
+  accent: "#7D56F4"
+  error: "9"
+keys:
+  boards:
+    createBoard: [n, ctrl+n]
+  list:
+    toggleHide: []
+`,
+			want: config.Config{
+				DB:            filepath.Join(home, "tasks.db"),
+				DefaultBoard:  "Inbox",
+				HideCompleted: true,
+				Clipboard:     "register",
+				Colors:        config.Colors{Accent: "#7D56F4", Error: "9"},
+				Keys: map[string]keybindings.Overrides{
+					"boards": {"createBoard": {"n", "ctrl+n"}},
+					"list":   {"toggleHide": {}},
+				},
+			},
+		},
+		{
+			name:    "unknown setting names its line",
+			content: "defaultBoard: Inbox\nhidecompleted: true\n",
+			wantErr: "line 2: field hidecompleted not found",
+		},
+		{
+			name:    "wrong type",
+			content: "hideCompleted: maybe\n",
+			wantErr: "line 1: cannot unmarshal",
+		},
+		{
+			name:    "unknown clipboard",
+			content: "clipboard: xclip\n",
+			wantErr: `clipboard: unknown backend "xclip", expected auto, system, osc52, register, none`,
+		},
+		{
+			name:    "invalid color",
+			content: "colors:\n  accent: purple\n",
+			wantErr: "colors.accent: invalid color \"purple\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfig(t, tt.content)
+			cfg, err := config.Load(path)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), path)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *cfg)
+		})
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	cfg, err := config.Load(filepath.Join(t.TempDir(), "config.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, config.Config{}, *cfg)
+}
+
+func TestLoadReportsEveryInvalidColor(t *testing.T) {
+	_, err := config.Load(writeConfig(t, "colors:\n  accent: purple\n  muted: \"256\"\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "colors.accent")
+	assert.Contains(t, err.Error(), "colors.muted")
+}
@@ -0,0 +1,189 @@
+// Package migrate applies and rolls back the goose migrations of the
+// database, backing the database up before every schema change.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pressly/goose/v3"
+)
+
+// backupTimeLayout is the timestamp in the names of backups.
+const backupTimeLayout = "20060102T150405Z"
+
+// Migration is a migration and whether it is applied to the database.
+type Migration struct {
+	Version int64
+	Name    string
+	Applied bool
+	// AppliedAt is zero for pending migrations.
+	AppliedAt time.Time
+}
+
+// Result is a migration applied or rolled back.
+type Result struct {
+	Version  int64
+	Name     string
+	Duration time.Duration
+}
+
+// Migrator manages the schema of the SQLite database at path.
+type Migrator struct {
+	db       *sql.DB
+	path     string
+	provider *goose.Provider
+	// Backup is the backup taken by the last schema change, empty when it
+	// took none.
+	Backup string
+}
+
+// New returns a migrator for db, stored at path, using the migrations in
+// fsys. A path of "" disables backups.
+func New(db *sql.DB, path string, fsys fs.FS) (*Migrator, error) {
+	provider, err := goose.NewProvider(goose.DialectSQLite3, db, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+	return &Migrator{db: db, path: path, provider: provider}, nil
+}
+
+// Status lists every migration in version order.
+func (m *Migrator) Status(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	migrations := make([]Migration, len(statuses))
+	for i, s := range statuses {
+		migrations[i] = Migration{
+			Version:   s.Source.Version,
+			Name:      filepath.Base(s.Source.Path),
+			Applied:   s.State == goose.StateApplied,
+			AppliedAt: s.AppliedAt,
+		}
+	}
+	return migrations, nil
+}
+
+// Version returns the version of the database and that of the latest
+// migration.
+func (m *Migrator) Version(ctx context.Context) (int64, int64, error) {
+	return m.provider.GetVersions(ctx)
+}
+
+// Pending reports how many migrations are not applied yet.
+func (m *Migrator) Pending(ctx context.Context) (int, error) {
+	migrations, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	for _, migration := range migrations {
+		if !migration.Applied {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) ([]Result, error) {
+	pending, err := m.Pending(ctx)
+	if err != nil || pending == 0 {
+		return nil, err
+	}
+	if err = m.backup(ctx); err != nil {
+		return nil, err
+	}
+	results, err := m.provider.Up(ctx)
+	return convert(results...), wrap("apply", err)
+}
+
+// Down rolls back the latest applied migration.
+func (m *Migrator) Down(ctx context.Context) (Result, error) {
+	if err := m.backup(ctx); err != nil {
+		return Result{}, err
+	}
+	result, err := m.provider.Down(ctx)
+	if errors.Is(err, goose.ErrNoNextVersion) {
+		return Result{}, errors.New("no migration to roll back")
+	}
+	if err != nil {
+		return Result{}, wrap("roll back", err)
+	}
+	return convert(result)[0], nil
+}
+
+// Redo rolls back the latest applied migration and applies it again.
+func (m *Migrator) Redo(ctx context.Context) (Result, error) {
+	down, err := m.Down(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+	up, err := m.provider.ApplyVersion(ctx, down.Version, true)
+	if err != nil {
+		return Result{}, wrap("apply", err)
+	}
+	return convert(up)[0], nil
+}
+
+// backup copies the database next to itself before a schema change, unless
+// it has no schema yet. The copy is named after the database, its version
+// and the time, such as data.db.v14-20261017T120000Z.bak.
+func (m *Migrator) backup(ctx context.Context) error {
+	m.Backup = ""
+	if m.path == "" {
+		return nil
+	}
+	version, err := m.provider.GetDBVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version == 0 {
+		return nil
+	}
+	path := fmt.Sprintf("%s.v%d-%s.bak", m.path, version, time.Now().UTC().Format(backupTimeLayout))
+	if _, err = os.Stat(path); err == nil {
+		return fmt.Errorf("failed to back up database: %s already exists", path)
+	}
+	// VACUUM INTO writes a consistent copy without locking out other readers.
+	if _, err = m.db.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("failed to back up database to %s: %w", path, err)
+	}
+	m.Backup = path
+	return nil
+}
+
+func convert(results ...*goose.MigrationResult) []Result {
+	converted := make([]Result, 0, len(results))
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		converted = append(converted, Result{
+			Version:  r.Source.Version,
+			Name:     filepath.Base(r.Source.Path),
+			Duration: r.Duration,
+		})
+	}
+	return converted
+}
+
+func wrap(action string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var partial *goose.PartialError
+	if errors.As(err, &partial) && partial.Failed != nil {
+		return fmt.Errorf("failed to %s migration %s: %w",
+			action, filepath.Base(partial.Failed.Source.Path), partial.Err)
+	}
+	return fmt.Errorf("failed to %s migrations: %w", action, err)
+}
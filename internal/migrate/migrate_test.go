@@ -0,0 +1,103 @@
+package migrate_test
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/migrate"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func newMigrator(t *testing.T) (*migrate.Migrator, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "data.db")
+	db, err := sql.Open("sqlite3", path+"?_foreign_keys=on")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	m, err := migrate.New(db, path, os.DirFS(filepath.Join("..", "..", "data", "sql", "migrations")))
+	require.NoError(t, err)
+	return m, path
+}
+
+func TestUpDownRedo(t *testing.T) {
+	ctx := testutil.MustContext()
+	m, path := newMigrator(t)
+
+	migrations, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	latest := migrations[len(migrations)-1]
+	assert.Equal(t, "00001_initial_schema.sql", migrations[0].Name)
+	assert.False(t, latest.Applied)
+
+	pending, err := m.Pending(ctx)
+	require.NoError(t, err)
+	assert.Len(t, migrations, pending)
+
+	results, err := m.Up(ctx)
+	require.NoError(t, err)
+	assert.Len(t, results, pending)
+	assert.Empty(t, m.Backup, "an empty database is not backed up")
+
+	current, target, err := m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest.Version, current)
+	assert.Equal(t, latest.Version, target)
+
+	results, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Empty(t, m.Backup, "nothing to apply takes no backup")
+
+	down, err := m.Down(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest.Name, down.Name)
+	require.NotEmpty(t, m.Backup)
+	assert.Equal(t, filepath.Dir(path), filepath.Dir(m.Backup))
+	assert.Contains(t, filepath.Base(m.Backup), filepath.Base(path)+".v")
+	assert.FileExists(t, m.Backup)
+
+	pending, err = m.Pending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pending)
+
+	redo, err := m.Redo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest.Version-1, redo.Version)
+	current, _, err = m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest.Version-1, current)
+}
+
+func TestBackupHasTheSchemaBeforeTheChange(t *testing.T) {
+	ctx := testutil.MustContext()
+	m, _ := newMigrator(t)
+	_, err := m.Up(ctx)
+	require.NoError(t, err)
+	current, _, err := m.Version(ctx)
+	require.NoError(t, err)
+
+	_, err = m.Down(ctx)
+	require.NoError(t, err)
+
+	backup, err := sql.Open("sqlite3", m.Backup)
+	require.NoError(t, err)
+	defer backup.Close()
+	var version int64
+	require.NoError(t, backup.QueryRowContext(ctx,
+		"SELECT MAX(version_id) FROM goose_db_version WHERE is_applied").Scan(&version))
+	assert.Equal(t, current, version)
+}
+
+func TestDownWithoutMigrations(t *testing.T) {
+	m, _ := newMigrator(t)
+	_, err := m.Down(testutil.MustContext())
+	require.EqualError(t, err, "no migration to roll back")
+}
@@ -0,0 +1,183 @@
+// Package paths locates the files of donezo following the XDG base directory
+// specification.
+package paths
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// EnvDB names the environment variable overriding the database path.
+const EnvDB = "DONEZO_DB"
+
+const (
+	appName        = "donezo"
+	dbFileName     = "data.db"
+	configFileName = "config.yaml"
+	legacyDir      = ".donezo"
+)
+
+// dbSidecars are the files SQLite keeps next to a database, moved along with it.
+//
+//nolint:gochecknoglobals // static list of suffixes
+var dbSidecars = []string{"", "-journal", "-wal", "-shm"}
+
+// DataDir returns $XDG_DATA_HOME/donezo, or ~/.local/share/donezo when the
+// variable is unset. Relative values are ignored as the specification asks.
+func DataDir() (string, error) {
+	return xdgDir("XDG_DATA_HOME", filepath.Join(".local", "share"))
+}
+
+// ConfigFile returns $XDG_CONFIG_HOME/donezo/config.yaml, or
+// ~/.config/donezo/config.yaml when the variable is unset.
+func ConfigFile() (string, error) {
+	dir, err := xdgDir("XDG_CONFIG_HOME", ".config")
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, configFileName), nil
+}
+
+func xdgDir(env, fallback string) (string, error) {
+	if dir := os.Getenv(env); filepath.IsAbs(dir) {
+		return filepath.Join(dir, appName), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine user home directory: %w", err)
+	}
+	return filepath.Join(home, fallback, appName), nil
+}
+
+// ResolveDB returns the database to open: path when it is set, otherwise
+// $DONEZO_DB, otherwise configured, the path from the config file, otherwise
+// data.db in DataDir. A database left in ~/.donezo by earlier versions is
+// moved to the default location the first time it is used. The directory of
+// the returned path exists.
+func ResolveDB(path, configured string) (string, error) {
+	if path == "" {
+		path = os.Getenv(EnvDB)
+	}
+	if path == "" {
+		path = configured
+	}
+	if path == "" {
+		dir, err := DataDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(dir, dbFileName)
+		if err = migrateLegacy(path); err != nil {
+			return "", err
+		}
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	return path, nil
+}
+
+// migrateLegacy moves ~/.donezo/data.db to path unless path already exists,
+// and removes ~/.donezo once it is empty.
+func migrateLegacy(path string) error {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return fmt.Errorf("unable to determine user home directory: %w", err)
+	}
+	oldDir := filepath.Join(home, legacyDir)
+	oldPath := filepath.Join(oldDir, dbFileName)
+	if _, err = os.Stat(oldPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to check database %s: %w", oldPath, err)
+	}
+	if _, err = os.Stat(path); err == nil {
+		return nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", filepath.Dir(path), err)
+	}
+	for _, suffix := range dbSidecars {
+		src := oldPath + suffix
+		if _, statErr := os.Stat(src); statErr != nil {
+			continue
+		}
+		if err = move(src, path+suffix); err != nil {
+			return fmt.Errorf("failed to move database %s to %s: %w", src, path+suffix, err)
+		}
+	}
+	// Other files may still live there, so the directory is only removed when
+	// empty.
+	_ = os.Remove(oldDir)
+	return nil
+}
+
+// move renames src to dst, falling back to copying when they are on
+// different file systems.
+func move(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		return errors.Join(err, out.Close(), os.Remove(dst))
+	}
+	if err = out.Close(); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
+
+// Expand replaces a leading ~ in path with the home directory.
+func Expand(path string) (string, error) {
+	rest, ok := strings.CutPrefix(path, "~")
+	if !ok || (rest != "" && rest[0] != '/' && rest[0] != filepath.Separator) {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine user home directory: %w", err)
+	}
+	return filepath.Join(home, rest), nil
+}
+
+// Display shortens path for display by writing the home directory as ~.
+func Display(path string) string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return path
+	}
+	if rel, ok := strings.CutPrefix(path, home+string(filepath.Separator)); ok {
+		return filepath.Join("~", rel)
+	}
+	return path
+}
+
+// DSN returns the SQLite connection string for the database at path, with
+// foreign keys enforced and, if readOnly is set, without write access. The
+// path is escaped into a file: URI, so a "?" or "#" in it is not taken for the
+// start of the parameters.
+func DSN(path string, readOnly bool) string {
+	params := "_foreign_keys=on"
+	if readOnly {
+		params = "mode=ro&" + params
+	}
+	return "file:" + url.PathEscape(path) + "?" + params
+}
@@ -0,0 +1,183 @@
+package paths_test
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/paths"
+)
+
+func TestResolveDB(t *testing.T) {
+	tests := []struct {
+		name   string
+		flag   string
+		env    string
+		config string
+		xdg    string
+		want   func(home, tmp string) string
+	}{
+		{
+			name: "defaults to the XDG data directory under home",
+			want: func(home, _ string) string { return filepath.Join(home, ".local", "share", "donezo", "data.db") },
+		},
+		{
+			name: "uses XDG_DATA_HOME",
+			xdg:  "xdg",
+			want: func(_, tmp string) string { return filepath.Join(tmp, "xdg", "donezo", "data.db") },
+		},
+		{
+			name:   "config file overrides default",
+			config: "config/tasks.db",
+			xdg:    "xdg",
+			want:   func(_, tmp string) string { return filepath.Join(tmp, "config", "tasks.db") },
+		},
+		{
+			name:   "environment variable overrides config file",
+			env:    "env/tasks.db",
+			config: "config/tasks.db",
+			want:   func(_, tmp string) string { return filepath.Join(tmp, "env", "tasks.db") },
+		},
+		{
+			name: "flag overrides environment variable",
+			flag: "flag/tasks.db",
+			env:  "env/tasks.db",
+			want: func(_, tmp string) string { return filepath.Join(tmp, "flag", "tasks.db") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmp := t.TempDir()
+			home := filepath.Join(tmp, "home")
+			t.Setenv("HOME", home)
+			t.Setenv("XDG_DATA_HOME", "")
+			t.Setenv(paths.EnvDB, "")
+			if tt.xdg != "" {
+				t.Setenv("XDG_DATA_HOME", filepath.Join(tmp, tt.xdg))
+			}
+			if tt.env != "" {
+				t.Setenv(paths.EnvDB, filepath.Join(tmp, tt.env))
+			}
+			flag, configured := "", ""
+			if tt.flag != "" {
+				flag = filepath.Join(tmp, tt.flag)
+			}
+			if tt.config != "" {
+				configured = filepath.Join(tmp, tt.config)
+			}
+
+			got, err := paths.ResolveDB(flag, configured)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want(home, tmp), got)
+			assert.DirExists(t, filepath.Dir(got))
+		})
+	}
+}
+
+func TestResolveDBMigratesLegacyDatabase(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   bool
+		wantLegacy bool
+	}{
+		{name: "moves database out of ~/.donezo"},
+		{name: "keeps database already in the data directory", existing: true, wantLegacy: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			t.Setenv("XDG_DATA_HOME", "")
+			t.Setenv(paths.EnvDB, "")
+
+			legacy := filepath.Join(home, ".donezo", "data.db")
+			require.NoError(t, os.MkdirAll(filepath.Dir(legacy), 0o700))
+			require.NoError(t, os.WriteFile(legacy, []byte("legacy"), 0o600))
+			want := filepath.Join(home, ".local", "share", "donezo", "data.db")
+			if tt.existing {
+				require.NoError(t, os.MkdirAll(filepath.Dir(want), 0o700))
+				require.NoError(t, os.WriteFile(want, []byte("current"), 0o600))
+			}
+
+			got, err := paths.ResolveDB("", "")
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+
+			data, err := os.ReadFile(got)
+			require.NoError(t, err)
+			if tt.wantLegacy {
+				assert.Equal(t, "current", string(data))
+				assert.FileExists(t, legacy)
+				return
+			}
+			assert.Equal(t, "legacy", string(data))
+			assert.NoDirExists(t, filepath.Dir(legacy))
+		})
+	}
+}
+
+func TestConfigFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	got, err := paths.ConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".config", "donezo", "config.yaml"), got)
+
+	t.Setenv("XDG_CONFIG_HOME", "/etc/xdg")
+	got, err = paths.ConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/xdg/donezo/config.yaml", got)
+}
+
+func TestExpand(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	for input, want := range map[string]string{
+		"~":            home,
+		"~/tasks.db":   filepath.Join(home, "tasks.db"),
+		"~bob/data.db": "~bob/data.db",
+		"/srv/data.db": "/srv/data.db",
+	} {
+		got, err := paths.Expand(input)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, input)
+	}
+}
+
+func TestDisplay(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	assert.Equal(t, filepath.Join("~", "data.db"), paths.Display(filepath.Join(home, "data.db")))
+	assert.Equal(t, "/srv/data.db", paths.Display("/srv/data.db"))
+}
+
+func TestDSN(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tasks?mode=memory#1.db")
+
+	db, err := sql.Open("sqlite3", paths.DSN(path, false))
+	require.NoError(t, err)
+	var foreignKeys int
+	require.NoError(t, db.QueryRow("PRAGMA foreign_keys").Scan(&foreignKeys))
+	assert.Equal(t, 1, foreignKeys)
+	_, err = db.Exec("CREATE TABLE t (id INTEGER)")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	assert.FileExists(t, path)
+
+	ro, err := sql.Open("sqlite3", paths.DSN(path, true))
+	require.NoError(t, err)
+	defer ro.Close()
+	_, err = ro.Exec("INSERT INTO t (id) VALUES (1)")
+	require.ErrorContains(t, err, "readonly")
+}
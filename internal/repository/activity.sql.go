@@ -0,0 +1,65 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: activity.sql
+
+package repository
+
+import (
+	"context"
+)
+
+const listActivity = `-- name: ListActivity :many
+SELECT id, board_id, item_id, subject, "action", detail, created_at FROM activity
+WHERE (?1 IS NULL OR item_id = ?1)
+  AND (?2 IS NULL OR board_id = ?2)
+  AND (?3 IS NULL OR created_at >= ?3)
+  AND (?4 IS NULL OR created_at < ?4)
+ORDER BY created_at DESC, id DESC
+LIMIT ?5
+`
+
+type ListActivityParams struct {
+	ItemID  interface{} `json:"itemId"`
+	BoardID interface{} `json:"boardId"`
+	Since   interface{} `json:"since"`
+	Until   interface{} `json:"until"`
+	Limit   int64       `json:"limit"`
+}
+
+func (q *Queries) ListActivity(ctx context.Context, arg ListActivityParams) ([]Activity, error) {
+	rows, err := q.db.QueryContext(ctx, listActivity,
+		arg.ItemID,
+		arg.BoardID,
+		arg.Since,
+		arg.Until,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.ItemID,
+			&i.Subject,
+			&i.Action,
+			&i.Detail,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -9,13 +9,39 @@ import (
 	"context"
 )
 
+const addBoardDefaultTag = `-- name: AddBoardDefaultTag :exec
+INSERT INTO board_default_tags (board_id, tag)
+VALUES (?, ?)
+ON CONFLICT(board_id, tag) DO NOTHING
+`
+
+type AddBoardDefaultTagParams struct {
+	BoardID int64  `json:"boardId"`
+	Tag     string `json:"tag"`
+}
+
+func (q *Queries) AddBoardDefaultTag(ctx context.Context, arg AddBoardDefaultTagParams) error {
+	_, err := q.db.ExecContext(ctx, addBoardDefaultTag, arg.BoardID, arg.Tag)
+	return err
+}
+
+const clearBoardDefaultTags = `-- name: ClearBoardDefaultTags :exec
+DELETE FROM board_default_tags
+WHERE board_id = ?
+`
+
+func (q *Queries) ClearBoardDefaultTags(ctx context.Context, boardID int64) error {
+	_, err := q.db.ExecContext(ctx, clearBoardDefaultTags, boardID)
+	return err
+}
+
 const createBoard = `-- name: CreateBoard :one
 INSERT INTO boards (
-  name
+  name, position
 ) VALUES (
-  ?
+  ?1, (SELECT COALESCE(MAX(position) + 1, 0) FROM boards)
 )
-RETURNING id, name, created_at, last_updated_at
+RETURNING id, name, created_at, last_updated_at, position, deleted_at
 `
 
 func (q *Queries) CreateBoard(ctx context.Context, name string) (Board, error) {
@@ -26,6 +52,8 @@ func (q *Queries) CreateBoard(ctx context.Context, name string) (Board, error) {
 		&i.Name,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
+		&i.Position,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -40,8 +68,18 @@ func (q *Queries) DeleteBoardByID(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteBoardDefaultTag = `-- name: DeleteBoardDefaultTag :exec
+DELETE FROM board_default_tags
+WHERE tag = ?
+`
+
+func (q *Queries) DeleteBoardDefaultTag(ctx context.Context, tag string) error {
+	_, err := q.db.ExecContext(ctx, deleteBoardDefaultTag, tag)
+	return err
+}
+
 const getBoardByID = `-- name: GetBoardByID :one
-SELECT id, name, created_at, last_updated_at FROM boards
+SELECT id, name, created_at, last_updated_at, position, deleted_at FROM boards
 WHERE id = ? LIMIT 1
 `
 
@@ -53,13 +91,73 @@ func (q *Queries) GetBoardByID(ctx context.Context, id int64) (Board, error) {
 		&i.Name,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
+		&i.Position,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const listAllBoardDefaultTags = `-- name: ListAllBoardDefaultTags :many
+SELECT board_id, tag FROM board_default_tags
+ORDER BY board_id, tag
+`
+
+func (q *Queries) ListAllBoardDefaultTags(ctx context.Context) ([]BoardDefaultTag, error) {
+	rows, err := q.db.QueryContext(ctx, listAllBoardDefaultTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BoardDefaultTag
+	for rows.Next() {
+		var i BoardDefaultTag
+		if err := rows.Scan(&i.BoardID, &i.Tag); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBoardDefaultTags = `-- name: ListBoardDefaultTags :many
+SELECT tag FROM board_default_tags
+WHERE board_id = ?
+ORDER BY tag
+`
+
+func (q *Queries) ListBoardDefaultTags(ctx context.Context, boardID int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listBoardDefaultTags, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listBoards = `-- name: ListBoards :many
-SELECT id, name, created_at, last_updated_at FROM boards
-ORDER BY id
+SELECT id, name, created_at, last_updated_at, position, deleted_at FROM boards
+WHERE deleted_at IS NULL
+ORDER BY position, id
 `
 
 func (q *Queries) ListBoards(ctx context.Context) ([]Board, error) {
@@ -76,6 +174,44 @@ func (q *Queries) ListBoards(ctx context.Context) ([]Board, error) {
 			&i.Name,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
+			&i.Position,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeletedBoards = `-- name: ListDeletedBoards :many
+SELECT id, name, created_at, last_updated_at, position, deleted_at FROM boards
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+`
+
+func (q *Queries) ListDeletedBoards(ctx context.Context) ([]Board, error) {
+	rows, err := q.db.QueryContext(ctx, listDeletedBoards)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Board
+	for rows.Next() {
+		var i Board
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.LastUpdatedAt,
+			&i.Position,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -90,12 +226,82 @@ func (q *Queries) ListBoards(ctx context.Context) ([]Board, error) {
 	return items, nil
 }
 
+const purgeDeletedBoards = `-- name: PurgeDeletedBoards :execrows
+DELETE FROM boards
+WHERE deleted_at IS NOT NULL AND deleted_at < datetime('now', ?1)
+`
+
+func (q *Queries) PurgeDeletedBoards(ctx context.Context, age interface{}) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeDeletedBoards, age)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const renameBoardDefaultTag = `-- name: RenameBoardDefaultTag :exec
+UPDATE OR REPLACE board_default_tags
+SET tag = ?1
+WHERE tag = ?2
+`
+
+type RenameBoardDefaultTagParams struct {
+	Target string `json:"target"`
+	Source string `json:"source"`
+}
+
+func (q *Queries) RenameBoardDefaultTag(ctx context.Context, arg RenameBoardDefaultTagParams) error {
+	_, err := q.db.ExecContext(ctx, renameBoardDefaultTag, arg.Target, arg.Source)
+	return err
+}
+
+const restoreBoardByID = `-- name: RestoreBoardByID :exec
+UPDATE boards
+SET
+    deleted_at = NULL,
+    last_updated_at = CURRENT_TIMESTAMP,
+    position = (SELECT COALESCE(MAX(b.position) + 1, 0) FROM boards b WHERE b.deleted_at IS NULL)
+WHERE boards.id = ?1
+`
+
+func (q *Queries) RestoreBoardByID(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, restoreBoardByID, id)
+	return err
+}
+
+const setBoardPositionByID = `-- name: SetBoardPositionByID :exec
+UPDATE boards
+SET position = ?
+WHERE id = ?
+`
+
+type SetBoardPositionByIDParams struct {
+	Position int64 `json:"position"`
+	ID       int64 `json:"id"`
+}
+
+func (q *Queries) SetBoardPositionByID(ctx context.Context, arg SetBoardPositionByIDParams) error {
+	_, err := q.db.ExecContext(ctx, setBoardPositionByID, arg.Position, arg.ID)
+	return err
+}
+
+const softDeleteBoardByID = `-- name: SoftDeleteBoardByID :exec
+UPDATE boards
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+func (q *Queries) SoftDeleteBoardByID(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, softDeleteBoardByID, id)
+	return err
+}
+
 const updateBoardByID = `-- name: UpdateBoardByID :one
 UPDATE boards
 SET name = ?,
 last_updated_at = CURRENT_TIMESTAMP
 WHERE boards.id = ?
-RETURNING id, name, created_at, last_updated_at
+RETURNING id, name, created_at, last_updated_at, position, deleted_at
 `
 
 type UpdateBoardByIDParams struct {
@@ -111,6 +317,8 @@ func (q *Queries) UpdateBoardByID(ctx context.Context, arg UpdateBoardByIDParams
 		&i.Name,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
+		&i.Position,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -20,7 +20,7 @@ func newTestQueries(t *testing.T) (*sql.DB, *repository.Queries) {
 	t.Helper()
 
 	dbPath := filepath.Join(t.TempDir(), "repository-test.db")
-	db, err := sql.Open("sqlite3", dbPath)
+	db, err := sql.Open("sqlite3", dbPath+"?_foreign_keys=on")
 	require.NoError(t, err)
 
 	baseFS, migrationsDir := migrationsFS(t)
@@ -0,0 +1,102 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: dependencies.sql
+
+package repository
+
+import (
+	"context"
+)
+
+const addItemDependency = `-- name: AddItemDependency :exec
+INSERT INTO item_dependencies (item_id, blocker_id)
+VALUES (?, ?)
+ON CONFLICT(item_id, blocker_id) DO NOTHING
+`
+
+type AddItemDependencyParams struct {
+	ItemID    int64 `json:"itemId"`
+	BlockerID int64 `json:"blockerId"`
+}
+
+func (q *Queries) AddItemDependency(ctx context.Context, arg AddItemDependencyParams) error {
+	_, err := q.db.ExecContext(ctx, addItemDependency, arg.ItemID, arg.BlockerID)
+	return err
+}
+
+const listBlockerIDsByItemID = `-- name: ListBlockerIDsByItemID :many
+SELECT blocker_id FROM item_dependencies
+WHERE item_id = ?
+ORDER BY blocker_id
+`
+
+func (q *Queries) ListBlockerIDsByItemID(ctx context.Context, itemID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, listBlockerIDsByItemID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var blocker_id int64
+		if err := rows.Scan(&blocker_id); err != nil {
+			return nil, err
+		}
+		items = append(items, blocker_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listItemDependencies = `-- name: ListItemDependencies :many
+SELECT item_id, blocker_id FROM item_dependencies
+`
+
+type ListItemDependenciesRow struct {
+	ItemID    int64 `json:"itemId"`
+	BlockerID int64 `json:"blockerId"`
+}
+
+func (q *Queries) ListItemDependencies(ctx context.Context) ([]ListItemDependenciesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listItemDependencies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListItemDependenciesRow
+	for rows.Next() {
+		var i ListItemDependenciesRow
+		if err := rows.Scan(&i.ItemID, &i.BlockerID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeItemDependency = `-- name: RemoveItemDependency :exec
+DELETE FROM item_dependencies
+WHERE item_id = ? AND blocker_id = ?
+`
+
+type RemoveItemDependencyParams struct {
+	ItemID    int64 `json:"itemId"`
+	BlockerID int64 `json:"blockerId"`
+}
+
+func (q *Queries) RemoveItemDependency(ctx context.Context, arg RemoveItemDependencyParams) error {
+	_, err := q.db.ExecContext(ctx, removeItemDependency, arg.ItemID, arg.BlockerID)
+	return err
+}
@@ -12,21 +12,27 @@ import (
 
 const createItem = `-- name: CreateItem :one
 INSERT INTO items (
-    board_id, title, description
+    board_id, title, description, due_at, position
 ) VALUES (
-    ?, ?, ?
+    ?1, ?2, ?3, ?4, (SELECT COALESCE(MAX(position) + 1, 0) FROM items WHERE board_id = ?1)
 )
-RETURNING id, board_id, title, description, completed, created_at, last_updated_at
+RETURNING id, board_id, title, description, completed, created_at, last_updated_at, due_at, priority, recurrence, position, deleted_at
 `
 
 type CreateItemParams struct {
-	BoardID     int64  `json:"boardId"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	BoardID     int64      `json:"boardId"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	DueAt       *time.Time `json:"dueAt"`
 }
 
 func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) (Item, error) {
-	row := q.db.QueryRowContext(ctx, createItem, arg.BoardID, arg.Title, arg.Description)
+	row := q.db.QueryRowContext(ctx, createItem,
+		arg.BoardID,
+		arg.Title,
+		arg.Description,
+		arg.DueAt,
+	)
 	var i Item
 	err := row.Scan(
 		&i.ID,
@@ -36,6 +42,11 @@ func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) (Item, e
 		&i.Completed,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
+		&i.DueAt,
+		&i.Priority,
+		&i.Recurrence,
+		&i.Position,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -50,6 +61,78 @@ func (q *Queries) DeleteItemByID(ctx context.Context, id int64) error {
 	return err
 }
 
+const getActiveItemByID = `-- name: GetActiveItemByID :one
+SELECT
+    i.id,
+    i.board_id,
+    i.title,
+    i.description,
+    i.completed,
+    i.created_at,
+    i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
+    COALESCE((SELECT json_group_array(t.tag) FROM tags t WHERE t.item_id = i.id), '[]') AS tags
+FROM items i
+JOIN boards b ON b.id = i.board_id
+WHERE i.id = ? AND i.deleted_at IS NULL AND b.deleted_at IS NULL
+`
+
+type GetActiveItemByIDRow struct {
+	ID            int64       `json:"id"`
+	BoardID       int64       `json:"boardId"`
+	Title         string      `json:"title"`
+	Description   string      `json:"description"`
+	Completed     bool        `json:"completed"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	LastUpdatedAt time.Time   `json:"lastUpdatedAt"`
+	DueAt         *time.Time  `json:"dueAt"`
+	Priority      int64       `json:"priority"`
+	Recurrence    string      `json:"recurrence"`
+	Position      int64       `json:"position"`
+	SubtasksTotal int64       `json:"subtasksTotal"`
+	SubtasksDone  int64       `json:"subtasksDone"`
+	BlockedBy     int64       `json:"blockedBy"`
+	Dependents    int64       `json:"dependents"`
+	Tags          interface{} `json:"tags"`
+}
+
+// GetActiveItemByID returns an item unless it or its board is in the trash.
+func (q *Queries) GetActiveItemByID(ctx context.Context, id int64) (GetActiveItemByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getActiveItemByID, id)
+	var i GetActiveItemByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.BoardID,
+		&i.Title,
+		&i.Description,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.LastUpdatedAt,
+		&i.DueAt,
+		&i.Priority,
+		&i.Recurrence,
+		&i.Position,
+		&i.SubtasksTotal,
+		&i.SubtasksDone,
+		&i.BlockedBy,
+		&i.Dependents,
+		&i.Tags,
+	)
+	return i, err
+}
+
 const getItemByID = `-- name: GetItemByID :one
 SELECT
     i.id,
@@ -59,6 +142,19 @@ SELECT
     i.completed,
     i.created_at,
     i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
     COALESCE(json_group_array(t.tag), '[]') AS tags
 FROM items i
 LEFT JOIN tags t ON i.id = t.item_id
@@ -74,6 +170,14 @@ type GetItemByIDRow struct {
 	Completed     bool        `json:"completed"`
 	CreatedAt     time.Time   `json:"createdAt"`
 	LastUpdatedAt time.Time   `json:"lastUpdatedAt"`
+	DueAt         *time.Time  `json:"dueAt"`
+	Priority      int64       `json:"priority"`
+	Recurrence    string      `json:"recurrence"`
+	Position      int64       `json:"position"`
+	SubtasksTotal int64       `json:"subtasksTotal"`
+	SubtasksDone  int64       `json:"subtasksDone"`
+	BlockedBy     int64       `json:"blockedBy"`
+	Dependents    int64       `json:"dependents"`
 	Tags          interface{} `json:"tags"`
 }
 
@@ -88,11 +192,93 @@ func (q *Queries) GetItemByID(ctx context.Context, id int64) (GetItemByIDRow, er
 		&i.Completed,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
+		&i.DueAt,
+		&i.Priority,
+		&i.Recurrence,
+		&i.Position,
+		&i.SubtasksTotal,
+		&i.SubtasksDone,
+		&i.BlockedBy,
+		&i.Dependents,
 		&i.Tags,
 	)
 	return i, err
 }
 
+const listDeletedItems = `-- name: ListDeletedItems :many
+SELECT
+    i.id,
+    i.board_id,
+    i.title,
+    i.description,
+    i.completed,
+    i.created_at,
+    i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    i.deleted_at,
+    b.name AS board_name
+FROM items i
+JOIN boards b ON b.id = i.board_id
+WHERE i.deleted_at IS NOT NULL
+ORDER BY i.deleted_at DESC, i.id
+`
+
+type ListDeletedItemsRow struct {
+	ID            int64      `json:"id"`
+	BoardID       int64      `json:"boardId"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	Completed     bool       `json:"completed"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	LastUpdatedAt time.Time  `json:"lastUpdatedAt"`
+	DueAt         *time.Time `json:"dueAt"`
+	Priority      int64      `json:"priority"`
+	Recurrence    string     `json:"recurrence"`
+	Position      int64      `json:"position"`
+	DeletedAt     *time.Time `json:"deletedAt"`
+	BoardName     string     `json:"boardName"`
+}
+
+func (q *Queries) ListDeletedItems(ctx context.Context) ([]ListDeletedItemsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDeletedItems)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeletedItemsRow
+	for rows.Next() {
+		var i ListDeletedItemsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.Title,
+			&i.Description,
+			&i.Completed,
+			&i.CreatedAt,
+			&i.LastUpdatedAt,
+			&i.DueAt,
+			&i.Priority,
+			&i.Recurrence,
+			&i.Position,
+			&i.DeletedAt,
+			&i.BoardName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listItemsByBoardID = `-- name: ListItemsByBoardID :many
 SELECT
     i.id,
@@ -102,12 +288,25 @@ SELECT
     i.completed,
     i.created_at,
     i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
     COALESCE(json_group_array(t.tag), '[]') AS tags
 FROM items i
 LEFT JOIN tags t ON i.id = t.item_id
-WHERE i.board_id = ?
+WHERE i.board_id = ? AND i.deleted_at IS NULL
 GROUP BY i.id
-ORDER BY i.created_at
+ORDER BY i.position, i.id
 `
 
 type ListItemsByBoardIDRow struct {
@@ -118,6 +317,14 @@ type ListItemsByBoardIDRow struct {
 	Completed     bool        `json:"completed"`
 	CreatedAt     time.Time   `json:"createdAt"`
 	LastUpdatedAt time.Time   `json:"lastUpdatedAt"`
+	DueAt         *time.Time  `json:"dueAt"`
+	Priority      int64       `json:"priority"`
+	Recurrence    string      `json:"recurrence"`
+	Position      int64       `json:"position"`
+	SubtasksTotal int64       `json:"subtasksTotal"`
+	SubtasksDone  int64       `json:"subtasksDone"`
+	BlockedBy     int64       `json:"blockedBy"`
+	Dependents    int64       `json:"dependents"`
 	Tags          interface{} `json:"tags"`
 }
 
@@ -138,6 +345,14 @@ func (q *Queries) ListItemsByBoardID(ctx context.Context, boardID int64) ([]List
 			&i.Completed,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
+			&i.DueAt,
+			&i.Priority,
+			&i.Recurrence,
+			&i.Position,
+			&i.SubtasksTotal,
+			&i.SubtasksDone,
+			&i.BlockedBy,
+			&i.Dependents,
 			&i.Tags,
 		); err != nil {
 			return nil, err
@@ -153,22 +368,121 @@ func (q *Queries) ListItemsByBoardID(ctx context.Context, boardID int64) ([]List
 	return items, nil
 }
 
+const moveItemToBoardByID = `-- name: MoveItemToBoardByID :one
+UPDATE items
+SET
+    board_id = ?1,
+    position = (SELECT COALESCE(MAX(i.position) + 1, 0) FROM items i WHERE i.board_id = ?1),
+    last_updated_at = CURRENT_TIMESTAMP
+WHERE items.id = ?2
+RETURNING id, board_id, title, description, completed, created_at, last_updated_at, due_at, priority, recurrence, position, deleted_at
+`
+
+type MoveItemToBoardByIDParams struct {
+	BoardID int64 `json:"boardId"`
+	ID      int64 `json:"id"`
+}
+
+func (q *Queries) MoveItemToBoardByID(ctx context.Context, arg MoveItemToBoardByIDParams) (Item, error) {
+	row := q.db.QueryRowContext(ctx, moveItemToBoardByID, arg.BoardID, arg.ID)
+	var i Item
+	err := row.Scan(
+		&i.ID,
+		&i.BoardID,
+		&i.Title,
+		&i.Description,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.LastUpdatedAt,
+		&i.DueAt,
+		&i.Priority,
+		&i.Recurrence,
+		&i.Position,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const purgeDeletedItems = `-- name: PurgeDeletedItems :execrows
+DELETE FROM items
+WHERE deleted_at IS NOT NULL AND deleted_at < datetime('now', ?1)
+`
+
+func (q *Queries) PurgeDeletedItems(ctx context.Context, age interface{}) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeDeletedItems, age)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreItemByID = `-- name: RestoreItemByID :exec
+UPDATE items
+SET
+    deleted_at = NULL,
+    last_updated_at = CURRENT_TIMESTAMP,
+    position = (
+        SELECT COALESCE(MAX(i.position) + 1, 0)
+        FROM items i
+        WHERE i.board_id = items.board_id AND i.deleted_at IS NULL
+    )
+WHERE items.id = ?1
+`
+
+func (q *Queries) RestoreItemByID(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, restoreItemByID, id)
+	return err
+}
+
+const setItemPositionByID = `-- name: SetItemPositionByID :exec
+UPDATE items
+SET position = ?
+WHERE id = ?
+`
+
+type SetItemPositionByIDParams struct {
+	Position int64 `json:"position"`
+	ID       int64 `json:"id"`
+}
+
+func (q *Queries) SetItemPositionByID(ctx context.Context, arg SetItemPositionByIDParams) error {
+	_, err := q.db.ExecContext(ctx, setItemPositionByID, arg.Position, arg.ID)
+	return err
+}
+
+const softDeleteItemByID = `-- name: SoftDeleteItemByID :exec
+UPDATE items
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+func (q *Queries) SoftDeleteItemByID(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, softDeleteItemByID, id)
+	return err
+}
+
 const updateItemByID = `-- name: UpdateItemByID :one
 UPDATE items
 SET
     title = ?,
     description = ?,
     completed = ?,
+    due_at = ?,
+    priority = ?,
+    recurrence = ?,
     last_updated_at = CURRENT_TIMESTAMP
 WHERE id = ?
-RETURNING id, board_id, title, description, completed, created_at, last_updated_at
+RETURNING id, board_id, title, description, completed, created_at, last_updated_at, due_at, priority, recurrence, position, deleted_at
 `
 
 type UpdateItemByIDParams struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed"`
-	ID          int64  `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Completed   bool       `json:"completed"`
+	DueAt       *time.Time `json:"dueAt"`
+	Priority    int64      `json:"priority"`
+	Recurrence  string     `json:"recurrence"`
+	ID          int64      `json:"id"`
 }
 
 func (q *Queries) UpdateItemByID(ctx context.Context, arg UpdateItemByIDParams) (Item, error) {
@@ -176,6 +490,9 @@ func (q *Queries) UpdateItemByID(ctx context.Context, arg UpdateItemByIDParams)
 		arg.Title,
 		arg.Description,
 		arg.Completed,
+		arg.DueAt,
+		arg.Priority,
+		arg.Recurrence,
 		arg.ID,
 	)
 	var i Item
@@ -187,6 +504,11 @@ func (q *Queries) UpdateItemByID(ctx context.Context, arg UpdateItemByIDParams)
 		&i.Completed,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
+		&i.DueAt,
+		&i.Priority,
+		&i.Recurrence,
+		&i.Position,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -82,6 +83,87 @@ func TestItemQueries(t *testing.T) {
 				assert.Equal(t, "tx item", items[0].Title)
 			},
 		},
+		{
+			name: "move item to board touches both boards",
+			run: func(t *testing.T, db *sql.DB, q *repository.Queries) {
+				ctx := context.Background()
+				source := mustCreateBoard(t, q, "Source")
+				target := mustCreateBoard(t, q, "Target")
+				mustCreateItem(t, q, target.ID, "already there", "")
+				item := mustCreateItem(t, q, source.ID, "task", "desc")
+
+				// Shifting the positions keeps the update trigger from overwriting the stale timestamp.
+				stale := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+				_, err := db.ExecContext(ctx, "UPDATE boards SET last_updated_at = ?, position = position + 1", stale)
+				require.NoError(t, err)
+
+				moved, err := q.MoveItemToBoardByID(ctx, repository.MoveItemToBoardByIDParams{
+					BoardID: target.ID,
+					ID:      item.ID,
+				})
+				require.NoError(t, err)
+				assert.Equal(t, item.ID, moved.ID)
+				assert.Equal(t, target.ID, moved.BoardID)
+				assert.Equal(t, int64(1), moved.Position)
+				assert.True(t, item.CreatedAt.Equal(moved.CreatedAt))
+
+				for _, id := range []int64{source.ID, target.ID} {
+					board, getErr := q.GetBoardByID(ctx, id)
+					require.NoError(t, getErr)
+					assert.True(t, board.LastUpdatedAt.After(stale), "board %d last_updated_at", id)
+				}
+			},
+		},
+		{
+			name: "soft deleted items are hidden and purged after retention",
+			run: func(t *testing.T, db *sql.DB, q *repository.Queries) {
+				ctx := context.Background()
+				board := mustCreateBoard(t, q, "Inbox")
+				stale := mustCreateItem(t, q, board.ID, "stale", "")
+				recent := mustCreateItem(t, q, board.ID, "recent", "")
+				require.NoError(t, q.SoftDeleteItemByID(ctx, stale.ID))
+				require.NoError(t, q.SoftDeleteItemByID(ctx, recent.ID))
+
+				_, err := db.ExecContext(ctx,
+					"UPDATE items SET deleted_at = datetime('now', '-2 days') WHERE id = ?", stale.ID)
+				require.NoError(t, err)
+
+				items, err := q.ListItemsByBoardID(ctx, board.ID)
+				require.NoError(t, err)
+				assert.Empty(t, items)
+
+				purged, err := q.PurgeDeletedItems(ctx, "-86400 seconds")
+				require.NoError(t, err)
+				assert.Equal(t, int64(1), purged)
+
+				deleted, err := q.ListDeletedItems(ctx)
+				require.NoError(t, err)
+				require.Len(t, deleted, 1)
+				assert.Equal(t, recent.ID, deleted[0].ID)
+				assert.Equal(t, "Inbox", deleted[0].BoardName)
+			},
+		},
+		{
+			name: "restoring an item touches it",
+			run: func(t *testing.T, db *sql.DB, q *repository.Queries) {
+				ctx := context.Background()
+				board := mustCreateBoard(t, q, "Inbox")
+				item := mustCreateItem(t, q, board.ID, "task", "")
+				mustCreateItem(t, q, board.ID, "other", "")
+				require.NoError(t, q.SoftDeleteItemByID(ctx, item.ID))
+
+				// Shifting the positions keeps the update trigger from overwriting the stale timestamp.
+				stale := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+				_, err := db.ExecContext(ctx, "UPDATE items SET last_updated_at = ?, position = position + 1", stale)
+				require.NoError(t, err)
+
+				require.NoError(t, q.RestoreItemByID(ctx, item.ID))
+				restored, err := q.GetActiveItemByID(ctx, item.ID)
+				require.NoError(t, err)
+				assert.Equal(t, int64(3), restored.Position)
+				assert.True(t, restored.LastUpdatedAt.After(stale))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -5,27 +5,73 @@
 package repository
 
 import (
+	"database/sql"
 	"time"
 )
 
+type Activity struct {
+	ID        int64         `json:"id"`
+	BoardID   int64         `json:"boardId"`
+	ItemID    sql.NullInt64 `json:"itemId"`
+	Subject   string        `json:"subject"`
+	Action    string        `json:"action"`
+	Detail    string        `json:"detail"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
 type Board struct {
-	ID            int64     `json:"id"`
-	Name          string    `json:"name"`
-	CreatedAt     time.Time `json:"createdAt"`
-	LastUpdatedAt time.Time `json:"lastUpdatedAt"`
+	ID            int64      `json:"id"`
+	Name          string     `json:"name"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	LastUpdatedAt time.Time  `json:"lastUpdatedAt"`
+	Position      int64      `json:"position"`
+	DeletedAt     *time.Time `json:"deletedAt"`
+}
+
+type BoardDefaultTag struct {
+	BoardID int64  `json:"boardId"`
+	Tag     string `json:"tag"`
 }
 
 type Item struct {
-	ID            int64     `json:"id"`
-	BoardID       int64     `json:"boardId"`
-	Title         string    `json:"title"`
-	Description   string    `json:"description"`
-	Completed     bool      `json:"completed"`
-	CreatedAt     time.Time `json:"createdAt"`
-	LastUpdatedAt time.Time `json:"lastUpdatedAt"`
+	ID            int64      `json:"id"`
+	BoardID       int64      `json:"boardId"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	Completed     bool       `json:"completed"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	LastUpdatedAt time.Time  `json:"lastUpdatedAt"`
+	DueAt         *time.Time `json:"dueAt"`
+	Priority      int64      `json:"priority"`
+	Recurrence    string     `json:"recurrence"`
+	Position      int64      `json:"position"`
+	DeletedAt     *time.Time `json:"deletedAt"`
+}
+
+type ItemDependency struct {
+	ItemID    int64     `json:"itemId"`
+	BlockerID int64     `json:"blockerId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Subtask struct {
+	ID        int64     `json:"id"`
+	ItemID    int64     `json:"itemId"`
+	Title     string    `json:"title"`
+	Completed bool      `json:"completed"`
+	Position  int64     `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 type Tag struct {
 	ItemID int64  `json:"itemId"`
 	Tag    string `json:"tag"`
 }
+
+type TagMetadata struct {
+	Tag           string    `json:"tag"`
+	Color         string    `json:"color"`
+	Emoji         string    `json:"emoji"`
+	Description   string    `json:"description"`
+	LastUpdatedAt time.Time `json:"lastUpdatedAt"`
+}
@@ -9,23 +9,67 @@ import (
 )
 
 type Querier interface {
+	AddBoardDefaultTag(ctx context.Context, arg AddBoardDefaultTagParams) error
+	AddItemDependency(ctx context.Context, arg AddItemDependencyParams) error
 	AddTagToItemByID(ctx context.Context, arg AddTagToItemByIDParams) error
+	ClearBoardDefaultTags(ctx context.Context, boardID int64) error
+	CopyTag(ctx context.Context, arg CopyTagParams) error
 	CountItemsByTag(ctx context.Context, tag string) (int64, error)
 	CreateBoard(ctx context.Context, name string) (Board, error)
 	CreateItem(ctx context.Context, arg CreateItemParams) (Item, error)
+	CreateSubtask(ctx context.Context, arg CreateSubtaskParams) (Subtask, error)
 	DeleteBoardByID(ctx context.Context, id int64) error
+	DeleteBoardDefaultTag(ctx context.Context, tag string) error
 	DeleteItemByID(ctx context.Context, id int64) error
+	DeleteSubtaskByID(ctx context.Context, id int64) error
 	DeleteTag(ctx context.Context, tag string) error
+	DeleteTagMetadata(ctx context.Context, tag string) error
+	// GetActiveItemByID returns an item unless it or its board is in the trash.
+	GetActiveItemByID(ctx context.Context, id int64) (GetActiveItemByIDRow, error)
 	GetBoardByID(ctx context.Context, id int64) (Board, error)
 	GetItemByID(ctx context.Context, id int64) (GetItemByIDRow, error)
+	GetSubtaskByID(ctx context.Context, id int64) (Subtask, error)
+	GetTagMetadata(ctx context.Context, tag string) (TagMetadata, error)
+	ListActivity(ctx context.Context, arg ListActivityParams) ([]Activity, error)
+	ListAllBoardDefaultTags(ctx context.Context) ([]BoardDefaultTag, error)
+	ListBlockerIDsByItemID(ctx context.Context, itemID int64) ([]int64, error)
+	ListBoardDefaultTags(ctx context.Context, boardID int64) ([]string, error)
 	ListBoards(ctx context.Context) ([]Board, error)
+	ListDeletedBoards(ctx context.Context) ([]Board, error)
+	ListDeletedItems(ctx context.Context) ([]ListDeletedItemsRow, error)
+	ListItemDependencies(ctx context.Context) ([]ListItemDependenciesRow, error)
 	ListItemsByBoardID(ctx context.Context, boardID int64) ([]ListItemsByBoardIDRow, error)
+	// Tags form a hierarchy separated by "/": a tag matches itself and every tag
+	// below it, so "work" matches "work" and "work/infra" but not "workshop".
 	ListItemsByTag(ctx context.Context, tag string) ([]ListItemsByTagRow, error)
+	ListSubtasksByItemID(ctx context.Context, itemID int64) ([]Subtask, error)
+	ListTagMetadata(ctx context.Context) ([]TagMetadata, error)
+	ListTagSubtree(ctx context.Context, tag string) ([]string, error)
+	// Every tag and each of its parents, counting the items of the whole subtree
+	// once each. Parents that are never used directly are included as well.
+	ListTagSummaries(ctx context.Context) ([]ListTagSummariesRow, error)
+	ListTagUsage(ctx context.Context) ([]ListTagUsageRow, error)
 	ListTags(ctx context.Context) ([]string, error)
 	ListTagsByItemID(ctx context.Context, itemID int64) ([]string, error)
+	MoveItemToBoardByID(ctx context.Context, arg MoveItemToBoardByIDParams) (Item, error)
+	PurgeDeletedBoards(ctx context.Context, age interface{}) (int64, error)
+	PurgeDeletedItems(ctx context.Context, age interface{}) (int64, error)
+	RemoveItemDependency(ctx context.Context, arg RemoveItemDependencyParams) error
 	RemoveTagFromItemByID(ctx context.Context, arg RemoveTagFromItemByIDParams) error
+	RenameBoardDefaultTag(ctx context.Context, arg RenameBoardDefaultTagParams) error
+	RenameTagMetadata(ctx context.Context, arg RenameTagMetadataParams) error
+	RestoreBoardByID(ctx context.Context, id int64) error
+	RestoreItemByID(ctx context.Context, id int64) error
+	SetBoardPositionByID(ctx context.Context, arg SetBoardPositionByIDParams) error
+	SetItemPositionByID(ctx context.Context, arg SetItemPositionByIDParams) error
+	SetSubtaskPositionByID(ctx context.Context, arg SetSubtaskPositionByIDParams) error
+	SoftDeleteBoardByID(ctx context.Context, id int64) error
+	SoftDeleteItemByID(ctx context.Context, id int64) error
+	TagExists(ctx context.Context, tag string) (int64, error)
 	UpdateBoardByID(ctx context.Context, arg UpdateBoardByIDParams) (Board, error)
 	UpdateItemByID(ctx context.Context, arg UpdateItemByIDParams) (Item, error)
+	UpdateSubtaskByID(ctx context.Context, arg UpdateSubtaskByIDParams) (Subtask, error)
+	UpsertTagMetadata(ctx context.Context, arg UpsertTagMetadataParams) (TagMetadata, error)
 }
 
 var _ Querier = (*Queries)(nil)
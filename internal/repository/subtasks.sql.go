@@ -0,0 +1,148 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: subtasks.sql
+
+package repository
+
+import (
+	"context"
+)
+
+const createSubtask = `-- name: CreateSubtask :one
+INSERT INTO subtasks (
+    item_id, title, position
+) VALUES (
+    ?1, ?2, (SELECT COALESCE(MAX(position) + 1, 0) FROM subtasks WHERE item_id = ?1)
+)
+RETURNING id, item_id, title, completed, position, created_at
+`
+
+type CreateSubtaskParams struct {
+	ItemID int64  `json:"itemId"`
+	Title  string `json:"title"`
+}
+
+func (q *Queries) CreateSubtask(ctx context.Context, arg CreateSubtaskParams) (Subtask, error) {
+	row := q.db.QueryRowContext(ctx, createSubtask, arg.ItemID, arg.Title)
+	var i Subtask
+	err := row.Scan(
+		&i.ID,
+		&i.ItemID,
+		&i.Title,
+		&i.Completed,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteSubtaskByID = `-- name: DeleteSubtaskByID :exec
+DELETE FROM subtasks
+WHERE id = ?
+`
+
+func (q *Queries) DeleteSubtaskByID(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteSubtaskByID, id)
+	return err
+}
+
+const getSubtaskByID = `-- name: GetSubtaskByID :one
+SELECT id, item_id, title, completed, position, created_at FROM subtasks
+WHERE id = ? LIMIT 1
+`
+
+func (q *Queries) GetSubtaskByID(ctx context.Context, id int64) (Subtask, error) {
+	row := q.db.QueryRowContext(ctx, getSubtaskByID, id)
+	var i Subtask
+	err := row.Scan(
+		&i.ID,
+		&i.ItemID,
+		&i.Title,
+		&i.Completed,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listSubtasksByItemID = `-- name: ListSubtasksByItemID :many
+SELECT id, item_id, title, completed, position, created_at FROM subtasks
+WHERE item_id = ?
+ORDER BY position, id
+`
+
+func (q *Queries) ListSubtasksByItemID(ctx context.Context, itemID int64) ([]Subtask, error) {
+	rows, err := q.db.QueryContext(ctx, listSubtasksByItemID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Subtask
+	for rows.Next() {
+		var i Subtask
+		if err := rows.Scan(
+			&i.ID,
+			&i.ItemID,
+			&i.Title,
+			&i.Completed,
+			&i.Position,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setSubtaskPositionByID = `-- name: SetSubtaskPositionByID :exec
+UPDATE subtasks
+SET position = ?
+WHERE id = ?
+`
+
+type SetSubtaskPositionByIDParams struct {
+	Position int64 `json:"position"`
+	ID       int64 `json:"id"`
+}
+
+func (q *Queries) SetSubtaskPositionByID(ctx context.Context, arg SetSubtaskPositionByIDParams) error {
+	_, err := q.db.ExecContext(ctx, setSubtaskPositionByID, arg.Position, arg.ID)
+	return err
+}
+
+const updateSubtaskByID = `-- name: UpdateSubtaskByID :one
+UPDATE subtasks
+SET
+    title = ?,
+    completed = ?
+WHERE id = ?
+RETURNING id, item_id, title, completed, position, created_at
+`
+
+type UpdateSubtaskByIDParams struct {
+	Title     string `json:"title"`
+	Completed bool   `json:"completed"`
+	ID        int64  `json:"id"`
+}
+
+func (q *Queries) UpdateSubtaskByID(ctx context.Context, arg UpdateSubtaskByIDParams) (Subtask, error) {
+	row := q.db.QueryRowContext(ctx, updateSubtaskByID, arg.Title, arg.Completed, arg.ID)
+	var i Subtask
+	err := row.Scan(
+		&i.ID,
+		&i.ItemID,
+		&i.Title,
+		&i.Completed,
+		&i.Position,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,126 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: tag_metadata.sql
+
+package repository
+
+import (
+	"context"
+)
+
+const deleteTagMetadata = `-- name: DeleteTagMetadata :exec
+DELETE FROM tag_metadata
+WHERE tag = ?
+`
+
+func (q *Queries) DeleteTagMetadata(ctx context.Context, tag string) error {
+	_, err := q.db.ExecContext(ctx, deleteTagMetadata, tag)
+	return err
+}
+
+const getTagMetadata = `-- name: GetTagMetadata :one
+SELECT tag, color, emoji, description, last_updated_at
+FROM tag_metadata
+WHERE tag = ?
+`
+
+func (q *Queries) GetTagMetadata(ctx context.Context, tag string) (TagMetadata, error) {
+	row := q.db.QueryRowContext(ctx, getTagMetadata, tag)
+	var i TagMetadata
+	err := row.Scan(
+		&i.Tag,
+		&i.Color,
+		&i.Emoji,
+		&i.Description,
+		&i.LastUpdatedAt,
+	)
+	return i, err
+}
+
+const listTagMetadata = `-- name: ListTagMetadata :many
+SELECT tag, color, emoji, description, last_updated_at
+FROM tag_metadata
+ORDER BY tag
+`
+
+func (q *Queries) ListTagMetadata(ctx context.Context) ([]TagMetadata, error) {
+	rows, err := q.db.QueryContext(ctx, listTagMetadata)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TagMetadata
+	for rows.Next() {
+		var i TagMetadata
+		if err := rows.Scan(
+			&i.Tag,
+			&i.Color,
+			&i.Emoji,
+			&i.Description,
+			&i.LastUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renameTagMetadata = `-- name: RenameTagMetadata :exec
+UPDATE OR REPLACE tag_metadata
+SET tag = ?1 || substr(tag, length(CAST(?2 AS TEXT)) + 1), last_updated_at = CURRENT_TIMESTAMP
+WHERE tag = ?2 OR substr(tag, 1, length(?2) + 1) = ?2 || '/'
+`
+
+type RenameTagMetadataParams struct {
+	Target string `json:"target"`
+	Source string `json:"source"`
+}
+
+func (q *Queries) RenameTagMetadata(ctx context.Context, arg RenameTagMetadataParams) error {
+	_, err := q.db.ExecContext(ctx, renameTagMetadata, arg.Target, arg.Source)
+	return err
+}
+
+const upsertTagMetadata = `-- name: UpsertTagMetadata :one
+INSERT INTO tag_metadata (tag, color, emoji, description)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(tag) DO UPDATE SET
+    color = excluded.color,
+    emoji = excluded.emoji,
+    description = excluded.description,
+    last_updated_at = CURRENT_TIMESTAMP
+RETURNING tag, color, emoji, description, last_updated_at
+`
+
+type UpsertTagMetadataParams struct {
+	Tag         string `json:"tag"`
+	Color       string `json:"color"`
+	Emoji       string `json:"emoji"`
+	Description string `json:"description"`
+}
+
+func (q *Queries) UpsertTagMetadata(ctx context.Context, arg UpsertTagMetadataParams) (TagMetadata, error) {
+	row := q.db.QueryRowContext(ctx, upsertTagMetadata,
+		arg.Tag,
+		arg.Color,
+		arg.Emoji,
+		arg.Description,
+	)
+	var i TagMetadata
+	err := row.Scan(
+		&i.Tag,
+		&i.Color,
+		&i.Emoji,
+		&i.Description,
+		&i.LastUpdatedAt,
+	)
+	return i, err
+}
@@ -26,11 +26,29 @@ func (q *Queries) AddTagToItemByID(ctx context.Context, arg AddTagToItemByIDPara
 	return err
 }
 
+const copyTag = `-- name: CopyTag :exec
+INSERT INTO tags (item_id, tag)
+SELECT t.item_id, ?1 FROM tags t WHERE t.tag = ?2
+ON CONFLICT(item_id, tag) DO NOTHING
+`
+
+type CopyTagParams struct {
+	Target string `json:"target"`
+	Source string `json:"source"`
+}
+
+func (q *Queries) CopyTag(ctx context.Context, arg CopyTagParams) error {
+	_, err := q.db.ExecContext(ctx, copyTag, arg.Target, arg.Source)
+	return err
+}
+
 const countItemsByTag = `-- name: CountItemsByTag :one
-SELECT COUNT(*)
+SELECT COUNT(DISTINCT i.id)
 FROM items i
+JOIN boards b ON b.id = i.board_id
 JOIN tags t ON i.id = t.item_id
-WHERE t.tag = ?
+WHERE (t.tag = ?1 OR substr(t.tag, 1, length(?1) + 1) = ?1 || '/')
+  AND i.deleted_at IS NULL AND b.deleted_at IS NULL
 `
 
 func (q *Queries) CountItemsByTag(ctx context.Context, tag string) (int64, error) {
@@ -59,11 +77,28 @@ SELECT
     i.completed,
     i.created_at,
     i.last_updated_at,
-    COALESCE(json_group_array(t2.tag), '[]') AS tags
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
+    COALESCE(json_group_array(t.tag), '[]') AS tags
 FROM items i
-JOIN tags t ON i.id = t.item_id
-LEFT JOIN tags t2 ON i.id = t2.item_id
-WHERE t.tag = ?
+JOIN boards b ON b.id = i.board_id
+LEFT JOIN tags t ON i.id = t.item_id
+WHERE EXISTS (
+    SELECT 1 FROM tags m
+    WHERE m.item_id = i.id
+      AND (m.tag = ?1 OR substr(m.tag, 1, length(?1) + 1) = ?1 || '/')
+) AND i.deleted_at IS NULL AND b.deleted_at IS NULL
 GROUP BY i.id
 ORDER BY i.created_at
 `
@@ -76,9 +111,19 @@ type ListItemsByTagRow struct {
 	Completed     bool        `json:"completed"`
 	CreatedAt     time.Time   `json:"createdAt"`
 	LastUpdatedAt time.Time   `json:"lastUpdatedAt"`
+	DueAt         *time.Time  `json:"dueAt"`
+	Priority      int64       `json:"priority"`
+	Recurrence    string      `json:"recurrence"`
+	Position      int64       `json:"position"`
+	SubtasksTotal int64       `json:"subtasksTotal"`
+	SubtasksDone  int64       `json:"subtasksDone"`
+	BlockedBy     int64       `json:"blockedBy"`
+	Dependents    int64       `json:"dependents"`
 	Tags          interface{} `json:"tags"`
 }
 
+// Tags form a hierarchy separated by "/": a tag matches itself and every tag
+// below it, so "work" matches "work" and "work/infra" but not "workshop".
 func (q *Queries) ListItemsByTag(ctx context.Context, tag string) ([]ListItemsByTagRow, error) {
 	rows, err := q.db.QueryContext(ctx, listItemsByTag, tag)
 	if err != nil {
@@ -96,6 +141,14 @@ func (q *Queries) ListItemsByTag(ctx context.Context, tag string) ([]ListItemsBy
 			&i.Completed,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
+			&i.DueAt,
+			&i.Priority,
+			&i.Recurrence,
+			&i.Position,
+			&i.SubtasksTotal,
+			&i.SubtasksDone,
+			&i.BlockedBy,
+			&i.Dependents,
 			&i.Tags,
 		); err != nil {
 			return nil, err
@@ -111,8 +164,152 @@ func (q *Queries) ListItemsByTag(ctx context.Context, tag string) ([]ListItemsBy
 	return items, nil
 }
 
+const listTagSubtree = `-- name: ListTagSubtree :many
+SELECT tag FROM tags
+WHERE tag = ?1 OR substr(tag, 1, length(?1) + 1) = ?1 || '/'
+UNION
+SELECT tag FROM board_default_tags
+WHERE tag = ?1 OR substr(tag, 1, length(?1) + 1) = ?1 || '/'
+UNION
+SELECT tag FROM tag_metadata
+WHERE tag = ?1 OR substr(tag, 1, length(?1) + 1) = ?1 || '/'
+ORDER BY tag
+`
+
+// Tags at or below tag, whether items, board defaults or metadata hold them.
+func (q *Queries) ListTagSubtree(ctx context.Context, tag string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listTagSubtree, tag)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTagSummaries = `-- name: ListTagSummaries :many
+WITH RECURSIVE paths AS (
+    SELECT
+        t.item_id AS item_id,
+        CASE WHEN instr(t.tag, '/') = 0 THEN t.tag ELSE substr(t.tag, 1, instr(t.tag, '/') - 1) END AS tag,
+        CASE WHEN instr(t.tag, '/') = 0 THEN '' ELSE substr(t.tag, instr(t.tag, '/') + 1) END AS rest
+    FROM tags t
+    UNION
+    SELECT
+        p.item_id,
+        p.tag || '/' || CASE WHEN instr(p.rest, '/') = 0 THEN p.rest ELSE substr(p.rest, 1, instr(p.rest, '/') - 1) END,
+        CASE WHEN instr(p.rest, '/') = 0 THEN '' ELSE substr(p.rest, instr(p.rest, '/') + 1) END
+    FROM paths p
+    WHERE p.rest <> ''
+)
+SELECT
+    CAST(p.tag AS TEXT) AS tag,
+    COUNT(DISTINCT i.id) AS total,
+    COUNT(DISTINCT CASE WHEN NOT i.completed THEN i.id END) AS open,
+    CAST(MAX(i.last_updated_at) AS TEXT) AS last_activity_at
+FROM paths p
+JOIN items i ON i.id = p.item_id
+JOIN boards b ON b.id = i.board_id
+WHERE i.deleted_at IS NULL AND b.deleted_at IS NULL
+GROUP BY p.tag
+ORDER BY p.tag
+`
+
+type ListTagSummariesRow struct {
+	Tag            string `json:"tag"`
+	Total          int64  `json:"total"`
+	Open           int64  `json:"open"`
+	LastActivityAt string `json:"lastActivityAt"`
+}
+
+// Every tag and each of its parents, counting the items of the whole subtree
+// once each. Parents that are never used directly are included as well.
+func (q *Queries) ListTagSummaries(ctx context.Context) ([]ListTagSummariesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTagSummaries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTagSummariesRow
+	for rows.Next() {
+		var i ListTagSummariesRow
+		if err := rows.Scan(
+			&i.Tag,
+			&i.Total,
+			&i.Open,
+			&i.LastActivityAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTagUsage = `-- name: ListTagUsage :many
+SELECT t.tag, COUNT(*) AS uses
+FROM tags t
+JOIN items i ON i.id = t.item_id
+JOIN boards b ON b.id = i.board_id
+WHERE i.deleted_at IS NULL AND b.deleted_at IS NULL
+GROUP BY t.tag
+ORDER BY uses DESC, t.tag
+`
+
+type ListTagUsageRow struct {
+	Tag  string `json:"tag"`
+	Uses int64  `json:"uses"`
+}
+
+func (q *Queries) ListTagUsage(ctx context.Context) ([]ListTagUsageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTagUsage)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTagUsageRow
+	for rows.Next() {
+		var i ListTagUsageRow
+		if err := rows.Scan(&i.Tag, &i.Uses); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTags = `-- name: ListTags :many
-SELECT DISTINCT tag FROM tags ORDER BY tag
+SELECT DISTINCT t.tag
+FROM tags t
+JOIN items i ON i.id = t.item_id
+JOIN boards b ON b.id = i.board_id
+WHERE i.deleted_at IS NULL AND b.deleted_at IS NULL
+ORDER BY t.tag
 `
 
 func (q *Queries) ListTags(ctx context.Context) ([]string, error) {
@@ -181,3 +378,14 @@ func (q *Queries) RemoveTagFromItemByID(ctx context.Context, arg RemoveTagFromIt
 	_, err := q.db.ExecContext(ctx, removeTagFromItemByID, arg.ItemID, arg.Tag)
 	return err
 }
+
+const tagExists = `-- name: TagExists :one
+SELECT EXISTS (SELECT 1 FROM tags WHERE tag = ?)
+`
+
+func (q *Queries) TagExists(ctx context.Context, tag string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, tagExists, tag)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
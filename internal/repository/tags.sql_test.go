@@ -2,8 +2,12 @@ package repository_test
 
 import (
 	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -72,3 +76,61 @@ func TestTagQueries(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeTagsMigration(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "migration-test.db")
+	db, err := sql.Open("sqlite3", dbPath+"?_foreign_keys=on")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	baseFS, migrationsDir := migrationsFS(t)
+	require.NoError(t, goose.SetDialect("sqlite3"))
+	goose.SetBaseFS(baseFS)
+	require.NoError(t, goose.UpTo(db, migrationsDir, 12))
+
+	q := repository.New(db)
+	board := mustCreateBoard(t, q, "Inbox")
+	itemA := mustCreateItem(t, q, board.ID, "a", "")
+	itemB := mustCreateItem(t, q, board.ID, "b", "")
+	for _, tag := range []repository.AddTagToItemByIDParams{
+		{ItemID: itemA.ID, Tag: "Work"},
+		{ItemID: itemA.ID, Tag: "work "},
+		{ItemID: itemB.ID, Tag: "work"},
+		{ItemID: itemB.ID, Tag: "Day  Job / Infra"},
+		{ItemID: itemB.ID, Tag: "  "},
+		{ItemID: itemB.ID, Tag: "C++ //\tTools/"},
+		{ItemID: itemB.ID, Tag: strings.Repeat("x", 60) + "/long"},
+	} {
+		require.NoError(t, q.AddTagToItemByID(ctx, tag))
+	}
+	var activity int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM activity").Scan(&activity))
+	_, err = q.UpsertTagMetadata(ctx, repository.UpsertTagMetadataParams{Tag: "Work", Emoji: "💼"})
+	require.NoError(t, err)
+	_, err = q.UpsertTagMetadata(ctx, repository.UpsertTagMetadataParams{Tag: "Day  Job / Infra", Color: "#fff"})
+	require.NoError(t, err)
+
+	require.NoError(t, goose.UpTo(db, migrationsDir, 13))
+
+	tagsA, err := q.ListTagsByItemID(ctx, itemA.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, tagsA)
+	tagsB, err := q.ListTagsByItemID(ctx, itemB.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c--/tools", "day-job/infra", "work", strings.Repeat("x", 60) + "/lon"}, tagsB)
+
+	var after int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM activity").Scan(&after))
+	assert.Equal(t, activity, after, "retagging is not logged")
+
+	meta, err := q.ListTagMetadata(ctx)
+	require.NoError(t, err)
+	require.Len(t, meta, 2)
+	assert.Equal(t, "day-job/infra", meta[0].Tag)
+	assert.Equal(t, "#fff", meta[0].Color)
+	assert.Equal(t, "work", meta[1].Tag)
+	assert.Equal(t, "💼", meta[1].Emoji)
+}
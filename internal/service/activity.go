@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rhajizada/donezo/internal/repository"
+)
+
+// Actions recorded in the activity log. Events are written by triggers, so
+// every change is captured no matter which code path made it.
+const (
+	ActionCreated   = "created"
+	ActionRenamed   = "renamed"
+	ActionEdited    = "edited"
+	ActionCompleted = "completed"
+	ActionReopened  = "reopened"
+	ActionMoved     = "moved"
+	ActionTagged    = "tagged"
+	ActionUntagged  = "untagged"
+	ActionDeleted   = "deleted"
+	ActionRestored  = "restored"
+	ActionPurged    = "purged"
+)
+
+// activityTimeLayout matches how SQLite stores CURRENT_TIMESTAMP, so the time
+// range filters compare correctly as text.
+const activityTimeLayout = "2006-01-02 15:04:05"
+
+// Activity is an entry of the activity log. ItemID is unset for board events.
+type Activity struct {
+	repository.Activity
+}
+
+// ActivityFilter narrows ListActivity. Zero values match everything.
+type ActivityFilter struct {
+	ItemID  int64
+	BoardID int64
+	// Since and Until bound the time range as [Since, Until).
+	Since time.Time
+	Until time.Time
+	// Limit caps the number of entries returned.
+	Limit int64
+}
+
+// IsBoardEvent reports whether a is about a board rather than an item.
+func (a Activity) IsBoardEvent() bool {
+	return !a.ItemID.Valid
+}
+
+// Summary describes a in a short sentence, e.g. `renamed item "b" (was "a")`.
+func (a Activity) Summary() string {
+	kind := "item"
+	if a.IsBoardEvent() {
+		kind = "board"
+	}
+	switch a.Action {
+	case ActionRenamed:
+		return fmt.Sprintf("renamed %s \"%s\" (was \"%s\")", kind, a.Subject, a.Detail)
+	case ActionEdited:
+		return fmt.Sprintf("edited description of \"%s\"", a.Subject)
+	case ActionMoved:
+		return fmt.Sprintf("moved \"%s\" from \"%s\"", a.Subject, a.Detail)
+	case ActionTagged:
+		return fmt.Sprintf("tagged \"%s\" with \"%s\"", a.Subject, a.Detail)
+	case ActionUntagged:
+		return fmt.Sprintf("removed tag \"%s\" from \"%s\"", a.Detail, a.Subject)
+	default:
+		return fmt.Sprintf("%s %s \"%s\"", a.Action, kind, a.Subject)
+	}
+}
+
+// ListActivity returns the activity log matching filter, newest first.
+func (s *Service) ListActivity(ctx context.Context, filter ActivityFilter) (*[]Activity, error) {
+	params := repository.ListActivityParams{
+		Limit: -1,
+	}
+	if filter.ItemID != 0 {
+		params.ItemID = filter.ItemID
+	}
+	if filter.BoardID != 0 {
+		params.BoardID = filter.BoardID
+	}
+	if !filter.Since.IsZero() {
+		params.Since = filter.Since.UTC().Format(activityTimeLayout)
+	}
+	if !filter.Until.IsZero() {
+		params.Until = filter.Until.UTC().Format(activityTimeLayout)
+	}
+	if filter.Limit > 0 {
+		params.Limit = filter.Limit
+	}
+
+	data, err := s.Repo.ListActivity(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	activity := make([]Activity, len(data))
+	for i, a := range data {
+		activity[i] = Activity{a}
+	}
+	return &activity, nil
+}
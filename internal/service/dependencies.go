@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rhajizada/donezo/internal/repository"
+)
+
+// AddBlocker records that item is blocked by blocker. Blockers may live on
+// other boards. Dependencies that would make an item wait on itself, directly
+// or through other items, are rejected.
+func (s *Service) AddBlocker(ctx context.Context, item *Item, blocker *Item) error {
+	if item.ID == blocker.ID {
+		return errors.New("item cannot block itself")
+	}
+	edges, err := s.Repo.ListItemDependencies(ctx)
+	if err != nil {
+		return err
+	}
+	blockers := make(map[int64][]int64, len(edges))
+	for _, e := range edges {
+		blockers[e.ItemID] = append(blockers[e.ItemID], e.BlockerID)
+	}
+	if reachable(blockers, blocker.ID, item.ID) {
+		return fmt.Errorf("\"%s\" already waits on \"%s\": dependency would create a cycle", blocker.Title, item.Title)
+	}
+	return s.Repo.AddItemDependency(ctx, repository.AddItemDependencyParams{
+		ItemID:    item.ID,
+		BlockerID: blocker.ID,
+	})
+}
+
+// reachable reports whether to can be reached from from by following edges.
+func reachable(edges map[int64][]int64, from, to int64) bool {
+	seen := map[int64]bool{from: true}
+	stack := []int64{from}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if current == to {
+			return true
+		}
+		for _, next := range edges[current] {
+			if !seen[next] {
+				seen[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	return false
+}
+
+// RemoveBlocker removes blocker from the blockers of item.
+func (s *Service) RemoveBlocker(ctx context.Context, item *Item, blocker *Item) error {
+	return s.Repo.RemoveItemDependency(ctx, repository.RemoveItemDependencyParams{
+		ItemID:    item.ID,
+		BlockerID: blocker.ID,
+	})
+}
+
+// ListBlockerIDs returns the ids of the items blocking item, including
+// completed ones.
+func (s *Service) ListBlockerIDs(ctx context.Context, item *Item) ([]int64, error) {
+	return s.Repo.ListBlockerIDsByItemID(ctx, item.ID)
+}
@@ -1,15 +1,52 @@
 package service
 
 import (
+	"time"
+
 	"github.com/rhajizada/donezo/internal/repository"
 )
 
 type Board struct {
 	repository.Board
+
+	DefaultTags []string `json:"defaultTags,omitempty"`
 }
 
 type Item struct {
 	repository.Item
 
-	Tags []string `json:"tags"`
+	Tags          []string  `json:"tags"`
+	SubtasksTotal int64     `json:"subtasksTotal"`
+	SubtasksDone  int64     `json:"subtasksDone"`
+	BlockedBy     int64     `json:"blockedBy"`
+	Dependents    int64     `json:"dependents"`
+	Subtasks      []Subtask `json:"subtasks,omitempty"`
+}
+
+// TagUsage is a tag with the number of items carrying it.
+type TagUsage struct {
+	repository.ListTagUsageRow
+}
+
+// TagSummary counts the open and completed items of a tag and everything
+// below it, along with the last time one of those items changed.
+type TagSummary struct {
+	Tag            string     `json:"tag"`
+	Open           int64      `json:"open"`
+	Done           int64      `json:"done"`
+	LastActivityAt *time.Time `json:"lastActivityAt,omitempty"`
+}
+
+// Total returns the number of items carrying the tag.
+func (t TagSummary) Total() int64 {
+	return t.Open + t.Done
+}
+
+// TagMetadata holds the color, emoji and description of a tag.
+type TagMetadata struct {
+	repository.TagMetadata
+}
+
+type Subtask struct {
+	repository.Subtask
 }
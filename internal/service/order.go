@@ -0,0 +1,171 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// ItemOrder selects how a listing of items is ordered.
+type ItemOrder uint8
+
+const (
+	// OrderByCreated keeps items in creation order.
+	OrderByCreated ItemOrder = iota
+	// OrderByDueDate puts the earliest due date first and items without one last.
+	OrderByDueDate
+	// OrderByPriority puts the most urgent items first.
+	OrderByPriority
+	// OrderByPosition keeps the manual order of items within their board.
+	OrderByPosition
+)
+
+// itemOrders lists the available orders in the sequence they are cycled through.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var itemOrders = []ItemOrder{OrderByPosition, OrderByCreated, OrderByDueDate, OrderByPriority}
+
+func (o ItemOrder) String() string {
+	switch o {
+	case OrderByCreated:
+		return "created date"
+	case OrderByDueDate:
+		return "due date"
+	case OrderByPriority:
+		return "priority"
+	case OrderByPosition:
+		return "manual order"
+	default:
+		return "unknown"
+	}
+}
+
+// Next returns the order that follows o, wrapping around after the last one.
+func (o ItemOrder) Next() ItemOrder {
+	idx := slices.Index(itemOrders, o)
+	return itemOrders[(idx+1)%len(itemOrders)]
+}
+
+// SortItems orders items in place, falling back to creation order for ties.
+func SortItems(items []Item, order ItemOrder) {
+	slices.SortStableFunc(items, func(a, b Item) int {
+		switch order {
+		case OrderByDueDate:
+			if c := compareDueAt(a, b); c != 0 {
+				return c
+			}
+		case OrderByPriority:
+			if c := cmp.Compare(b.Priority, a.Priority); c != 0 {
+				return c
+			}
+		case OrderByPosition:
+			if c := cmp.Compare(a.Position, b.Position); c != 0 {
+				return c
+			}
+		case OrderByCreated:
+		}
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+}
+
+func compareDueAt(a, b Item) int {
+	switch {
+	case a.DueAt == nil && b.DueAt == nil:
+		return 0
+	case a.DueAt == nil:
+		return 1
+	case b.DueAt == nil:
+		return -1
+	default:
+		return a.DueAt.Compare(*b.DueAt)
+	}
+}
+
+// TagOrder selects how a listing of tags is ordered.
+type TagOrder uint8
+
+const (
+	// OrderTagsByName orders tags alphabetically.
+	OrderTagsByName TagOrder = iota
+	// OrderTagsByOpen puts the tags with the most open items first.
+	OrderTagsByOpen
+	// OrderTagsByRecent puts the most recently active tags first.
+	OrderTagsByRecent
+)
+
+// tagOrders lists the available tag orders in the sequence they are cycled
+// through.
+//
+//nolint:gochecknoglobals // fixed lookup table
+var tagOrders = []TagOrder{OrderTagsByName, OrderTagsByOpen, OrderTagsByRecent}
+
+func (o TagOrder) String() string {
+	switch o {
+	case OrderTagsByName:
+		return "name"
+	case OrderTagsByOpen:
+		return "most open"
+	case OrderTagsByRecent:
+		return "most recent"
+	default:
+		return "unknown"
+	}
+}
+
+// Next returns the order that follows o, wrapping around after the last one.
+func (o TagOrder) Next() TagOrder {
+	idx := slices.Index(tagOrders, o)
+	return tagOrders[(idx+1)%len(tagOrders)]
+}
+
+// SortTags orders tags in place while keeping the hierarchy intact: every tag
+// comes right before the tags below it, and tags sharing a parent are ordered
+// among themselves. tags should include the parents of every tag, as returned
+// by ListTagSummaries; ties are broken by name.
+func SortTags(tags []TagSummary, order TagOrder) {
+	byTag := make(map[string]TagSummary, len(tags))
+	for _, t := range tags {
+		byTag[t.Tag] = t
+	}
+	slices.SortStableFunc(tags, func(a, b TagSummary) int {
+		as := strings.Split(a.Tag, TagPathSeparator)
+		bs := strings.Split(b.Tag, TagPathSeparator)
+		depth := 0
+		for depth < len(as) && depth < len(bs) && as[depth] == bs[depth] {
+			depth++
+		}
+		if depth == len(as) || depth == len(bs) {
+			return cmp.Compare(len(as), len(bs))
+		}
+		// Compare the ancestors of a and b right where their paths diverge.
+		x := byTag[strings.Join(as[:depth+1], TagPathSeparator)]
+		y := byTag[strings.Join(bs[:depth+1], TagPathSeparator)]
+		switch order {
+		case OrderTagsByOpen:
+			if c := cmp.Compare(y.Open, x.Open); c != 0 {
+				return c
+			}
+		case OrderTagsByRecent:
+			if c := compareActivity(x, y); c != 0 {
+				return c
+			}
+		case OrderTagsByName:
+		}
+		return cmp.Compare(as[depth], bs[depth])
+	})
+}
+
+// compareActivity puts the most recent activity first and tags without any
+// activity last.
+func compareActivity(a, b TagSummary) int {
+	switch {
+	case a.LastActivityAt == nil && b.LastActivityAt == nil:
+		return 0
+	case a.LastActivityAt == nil:
+		return 1
+	case b.LastActivityAt == nil:
+		return -1
+	default:
+		return b.LastActivityAt.Compare(*a.LastActivityAt)
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/rhajizada/donezo/internal/repository"
+)
+
+// errEntryNotFound is returned by reorder when the moved entry is missing.
+var errEntryNotFound = errors.New("entry not found")
+
+// positioned is an entry of a manually ordered listing.
+type positioned struct {
+	ID       int64
+	Position int64
+}
+
+// reorder moves the entry with id to index within entries, which must be in
+// display order. Out of range indexes are clamped to the start or end. Every
+// entry whose position no longer matches its index is saved with setPosition,
+// which also closes gaps left by deleted entries. Callers run it in a
+// transaction so a failed save leaves no duplicate or missing positions.
+func reorder(
+	entries []positioned,
+	id int64,
+	index func(current int) int,
+	setPosition func(id, position int64) error,
+) error {
+	current := slices.IndexFunc(entries, func(e positioned) bool { return e.ID == id })
+	if current < 0 {
+		return errEntryNotFound
+	}
+	moved := entries[current]
+	entries = slices.Delete(entries, current, current+1)
+	target := max(0, min(index(current), len(entries)))
+	entries = slices.Insert(entries, target, moved)
+
+	for i, e := range entries {
+		if e.Position == int64(i) {
+			continue
+		}
+		if err := setPosition(e.ID, int64(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func at(index int) func(int) int {
+	return func(int) int { return index }
+}
+
+func by(delta int) func(int) int {
+	return func(current int) int { return current + delta }
+}
+
+// MoveBoardTo moves board to index in the board listing.
+func (s *Service) MoveBoardTo(ctx context.Context, board *Board, index int) error {
+	return s.moveBoard(ctx, board, at(index))
+}
+
+// MoveBoardUp moves board one place towards the top of the board listing.
+func (s *Service) MoveBoardUp(ctx context.Context, board *Board) error {
+	return s.moveBoard(ctx, board, by(-1))
+}
+
+// MoveBoardDown moves board one place towards the bottom of the board listing.
+func (s *Service) MoveBoardDown(ctx context.Context, board *Board) error {
+	return s.moveBoard(ctx, board, by(1))
+}
+
+func (s *Service) moveBoard(ctx context.Context, board *Board, index func(int) int) error {
+	return s.withTx(ctx, func(q *repository.Queries) error {
+		data, err := q.ListBoards(ctx)
+		if err != nil {
+			return err
+		}
+		entries := make([]positioned, len(data))
+		for i, b := range data {
+			entries[i] = positioned{ID: b.ID, Position: b.Position}
+		}
+		return reorder(entries, board.ID, index, func(id, position int64) error {
+			return q.SetBoardPositionByID(ctx, repository.SetBoardPositionByIDParams{
+				Position: position,
+				ID:       id,
+			})
+		})
+	})
+}
+
+// MoveItemTo moves item to index within the items of its board.
+func (s *Service) MoveItemTo(ctx context.Context, item *Item, index int) error {
+	return s.moveItem(ctx, item, at(index))
+}
+
+// MoveItemUp moves item one place towards the top of its board.
+func (s *Service) MoveItemUp(ctx context.Context, item *Item) error {
+	return s.moveItem(ctx, item, by(-1))
+}
+
+// MoveItemDown moves item one place towards the bottom of its board.
+func (s *Service) MoveItemDown(ctx context.Context, item *Item) error {
+	return s.moveItem(ctx, item, by(1))
+}
+
+func (s *Service) moveItem(ctx context.Context, item *Item, index func(int) int) error {
+	return s.withTx(ctx, func(q *repository.Queries) error {
+		data, err := q.ListItemsByBoardID(ctx, item.BoardID)
+		if err != nil {
+			return err
+		}
+		entries := make([]positioned, len(data))
+		for i, v := range data {
+			entries[i] = positioned{ID: v.ID, Position: v.Position}
+		}
+		return reorder(entries, item.ID, index, func(id, position int64) error {
+			return q.SetItemPositionByID(ctx, repository.SetItemPositionByIDParams{
+				Position: position,
+				ID:       id,
+			})
+		})
+	})
+}
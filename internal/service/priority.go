@@ -0,0 +1,76 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Priority is the urgency level stored in items.priority.
+type Priority int64
+
+const (
+	PriorityNone Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityUrgent
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityNone:
+		return "none"
+	case PriorityLow:
+		return "low"
+	case PriorityMedium:
+		return "medium"
+	case PriorityHigh:
+		return "high"
+	case PriorityUrgent:
+		return "urgent"
+	default:
+		return "unknown"
+	}
+}
+
+// Valid reports whether p is one of the known priority levels.
+func (p Priority) Valid() bool {
+	return p >= PriorityNone && p <= PriorityUrgent
+}
+
+// Next returns the priority that follows p, wrapping from urgent back to none.
+func (p Priority) Next() Priority {
+	if p >= PriorityUrgent || p < PriorityNone {
+		return PriorityNone
+	}
+	return p + 1
+}
+
+// Marker returns a short marker for list views, one "!" per level.
+func (p Priority) Marker() string {
+	if !p.Valid() {
+		return ""
+	}
+	return strings.Repeat("!", int(p))
+}
+
+// ParsePriority parses a priority by name, such as "high", or by level from 0
+// to 4.
+func ParsePriority(input string) (Priority, error) {
+	sanitized := strings.ToLower(strings.TrimSpace(input))
+	if level, err := strconv.Atoi(sanitized); err == nil {
+		if p := Priority(level); p.Valid() {
+			return p, nil
+		}
+	}
+	for p := PriorityNone; p <= PriorityUrgent; p++ {
+		if p.String() == sanitized {
+			return p, nil
+		}
+	}
+	return PriorityNone, fmt.Errorf(
+		"unknown priority %q: expected none, low, medium, high, urgent or %d-%d",
+		input, PriorityNone, PriorityUrgent,
+	)
+}
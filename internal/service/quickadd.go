@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/rhajizada/donezo/internal/repository"
+)
+
+// QuickAdd is an item described in a single line such as
+//
+//	Pay rent #finance #home @Personal -- rent for november
+//
+// Words starting with "#" are tags, a word starting with "@" names the board
+// and everything after a standalone "--" is the description. Board names
+// with spaces are quoted as in @"Side Projects", and a leading backslash as
+// in \#1 or \-- keeps a word in the title.
+type QuickAdd struct {
+	Title       string
+	Description string
+	// Board is the name of the target board, empty when none was given.
+	Board string
+	Tags  []string
+}
+
+// ParseQuickAdd parses a quick-add line. Tags are brought into canonical form.
+func ParseQuickAdd(input string) (QuickAdd, error) {
+	var q QuickAdd
+	var title, tags []string
+	rest := input
+	for {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			break
+		}
+		if name, ok := strings.CutPrefix(rest, `@"`); ok {
+			end := strings.IndexByte(name, '"')
+			if end < 0 {
+				return QuickAdd{}, errors.New("unterminated board name: missing closing '\"'")
+			}
+			if err := q.setBoard(name[:end]); err != nil {
+				return QuickAdd{}, err
+			}
+			rest = name[end+1:]
+			continue
+		}
+
+		end := strings.IndexFunc(rest, unicode.IsSpace)
+		if end < 0 {
+			end = len(rest)
+		}
+		word := rest[:end]
+		rest = rest[end:]
+		switch {
+		case word == "--":
+			q.Description = strings.TrimSpace(rest)
+			rest = ""
+		case len(word) > 1 && word[0] == '\\' && (strings.ContainsRune("#@", rune(word[1])) || word[1:] == "--"):
+			title = append(title, word[1:])
+		case len(word) > 1 && word[0] == '#':
+			tags = append(tags, word[1:])
+		case len(word) > 1 && word[0] == '@':
+			if err := q.setBoard(word[1:]); err != nil {
+				return QuickAdd{}, err
+			}
+		default:
+			title = append(title, word)
+		}
+	}
+
+	q.Title = strings.Join(title, " ")
+	if q.Title == "" {
+		return QuickAdd{}, errors.New("item title must not be empty")
+	}
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return QuickAdd{}, err
+	}
+	if len(normalized) > 0 {
+		q.Tags = normalized
+	}
+	return q, nil
+}
+
+func (q *QuickAdd) setBoard(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("board name must not be empty")
+	}
+	if q.Board != "" {
+		return fmt.Errorf("more than one board given: %q and %q", q.Board, name)
+	}
+	q.Board = name
+	return nil
+}
+
+// String describes the parsed item for a preview before it is saved.
+func (q QuickAdd) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%q", q.Title)
+	if q.Board != "" {
+		fmt.Fprintf(&b, " in %q", q.Board)
+	}
+	if len(q.Tags) > 0 {
+		b.WriteString(" #" + strings.Join(q.Tags, " #"))
+	}
+	if q.Description != "" {
+		fmt.Fprintf(&b, " -- %s", q.Description)
+	}
+	return b.String()
+}
+
+// CreateQuickAdd creates the item described by q in board, tagged with the
+// default tags of the board and the tags of q. The board named by q is not
+// looked up; callers resolve it to board.
+func (s *Service) CreateQuickAdd(ctx context.Context, board *Board, q QuickAdd) (*Item, error) {
+	return s.CreateItemFrom(ctx, board, &Item{
+		Item: repository.Item{Title: q.Title, Description: q.Description},
+		Tags: q.Tags,
+	})
+}
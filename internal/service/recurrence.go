@@ -0,0 +1,155 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrenceKind is the kind of schedule a recurring item follows.
+type RecurrenceKind uint8
+
+const (
+	RecurNone RecurrenceKind = iota
+	RecurDaily
+	RecurWeekly
+	RecurMonthly
+	RecurEveryNDays
+)
+
+// Recurrence is a parsed items.recurrence rule. Rules are stored in the
+// canonical form produced by String:
+//
+//	daily
+//	weekly mon,thu
+//	monthly 15
+//	every 3 days
+type Recurrence struct {
+	Kind     RecurrenceKind
+	Weekdays []time.Weekday
+	Day      int
+	Interval int
+}
+
+const daysInWeek = 7
+
+//nolint:gochecknoglobals // fixed lookup table
+var weekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseRecurrence parses a recurrence rule. An empty rule means the item does not recur.
+func ParseRecurrence(rule string) (Recurrence, error) {
+	fields := strings.Fields(strings.ToLower(rule))
+	if len(fields) == 0 {
+		return Recurrence{Kind: RecurNone}, nil
+	}
+
+	switch {
+	case len(fields) == 1 && fields[0] == "daily":
+		return Recurrence{Kind: RecurDaily}, nil
+	case fields[0] == "weekly" && len(fields) <= 2:
+		r := Recurrence{Kind: RecurWeekly}
+		if len(fields) == 2 {
+			for name := range strings.SplitSeq(fields[1], ",") {
+				day, err := parseWeekday(name)
+				if err != nil {
+					return Recurrence{}, err
+				}
+				if !slices.Contains(r.Weekdays, day) {
+					r.Weekdays = append(r.Weekdays, day)
+				}
+			}
+			slices.Sort(r.Weekdays)
+		}
+		return r, nil
+	case fields[0] == "monthly" && len(fields) == 2:
+		day, err := strconv.Atoi(fields[1])
+		if err != nil || day < 1 || day > 31 {
+			return Recurrence{}, fmt.Errorf("invalid day of month %q: must be between 1 and 31", fields[1])
+		}
+		return Recurrence{Kind: RecurMonthly, Day: day}, nil
+	case fields[0] == "every" && len(fields) == 3 && (fields[2] == "days" || fields[2] == "day"):
+		interval, err := strconv.Atoi(fields[1])
+		if err != nil || interval < 1 {
+			return Recurrence{}, fmt.Errorf("invalid interval %q: must be a positive number of days", fields[1])
+		}
+		return Recurrence{Kind: RecurEveryNDays, Interval: interval}, nil
+	default:
+		return Recurrence{}, fmt.Errorf(
+			"invalid recurrence %q: expected daily, weekly [days], monthly <day> or every <n> days", rule,
+		)
+	}
+}
+
+func parseWeekday(name string) (time.Weekday, error) {
+	if len(name) >= 3 {
+		if idx := slices.Index(weekdayNames, name[:3]); idx >= 0 &&
+			strings.HasPrefix(strings.ToLower(time.Weekday(idx).String()), name) {
+			return time.Weekday(idx), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", name)
+}
+
+// String renders r in the canonical form accepted by ParseRecurrence.
+func (r Recurrence) String() string {
+	switch r.Kind {
+	case RecurNone:
+		return ""
+	case RecurDaily:
+		return "daily"
+	case RecurWeekly:
+		if len(r.Weekdays) == 0 {
+			return "weekly"
+		}
+		names := make([]string, len(r.Weekdays))
+		for i, day := range r.Weekdays {
+			names[i] = weekdayNames[day]
+		}
+		return "weekly " + strings.Join(names, ",")
+	case RecurMonthly:
+		return fmt.Sprintf("monthly %d", r.Day)
+	case RecurEveryNDays:
+		if r.Interval == 1 {
+			return "every 1 day"
+		}
+		return fmt.Sprintf("every %d days", r.Interval)
+	default:
+		return ""
+	}
+}
+
+// Next returns the first occurrence strictly after the calendar day of after,
+// at midnight in the location of after. A weekly rule without weekdays repeats
+// on the same weekday as after.
+func (r Recurrence) Next(after time.Time) time.Time {
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, after.Location())
+	switch r.Kind {
+	case RecurDaily:
+		return day.AddDate(0, 0, 1)
+	case RecurWeekly:
+		if len(r.Weekdays) == 0 {
+			return day.AddDate(0, 0, daysInWeek)
+		}
+		for offset := 1; offset <= daysInWeek; offset++ {
+			next := day.AddDate(0, 0, offset)
+			if slices.Contains(r.Weekdays, next.Weekday()) {
+				return next
+			}
+		}
+	case RecurMonthly:
+		for offset := 0; ; offset++ {
+			// Day 0 of the following month is the last day of this one.
+			last := time.Date(day.Year(), day.Month()+time.Month(offset)+1, 0, 0, 0, 0, 0, day.Location())
+			next := time.Date(last.Year(), last.Month(), min(r.Day, last.Day()), 0, 0, 0, 0, day.Location())
+			if next.After(day) {
+				return next
+			}
+		}
+	case RecurEveryNDays:
+		return day.AddDate(0, 0, r.Interval)
+	case RecurNone:
+	}
+	return day
+}
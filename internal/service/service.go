@@ -2,24 +2,42 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/rhajizada/donezo/internal/repository"
 )
 
 type Service struct {
 	Repo *repository.Queries
+	db   *sql.DB
 }
 
-func New(r *repository.Queries) *Service {
+func New(db *sql.DB) *Service {
 	return &Service{
-		Repo: r,
+		Repo: repository.New(db),
+		db:   db,
 	}
 }
 
+// withTx runs fn with queries bound to a single transaction, committing when
+// fn succeeds and rolling back otherwise.
+func (s *Service) withTx(ctx context.Context, fn func(q *repository.Queries) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(s.Repo.WithTx(tx)); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
 // unmarshalTags converts the interface returned from sqlc for the tags field
 // into a slice of strings by performing the proper type assertions.
 func unmarshalTags(v any) []string {
@@ -42,15 +60,23 @@ func unmarshalTags(v any) []string {
 	return tags
 }
 
-// ListBoards returns all boards.
+// ListBoards returns all boards with their default tags.
 func (s *Service) ListBoards(ctx context.Context) (*[]Board, error) {
 	data, err := s.Repo.ListBoards(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defaults, err := s.Repo.ListAllBoardDefaultTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tagsByBoard := make(map[int64][]string)
+	for _, d := range defaults {
+		tagsByBoard[d.BoardID] = append(tagsByBoard[d.BoardID], d.Tag)
+	}
 	boards := make([]Board, len(data))
 	for i, b := range data {
-		boards[i] = Board{b}
+		boards[i] = Board{Board: b, DefaultTags: tagsByBoard[b.ID]}
 	}
 	return &boards, nil
 }
@@ -60,7 +86,7 @@ func (s *Service) CreateBoard(ctx context.Context, boardName string) (*Board, er
 	if err != nil {
 		return nil, err
 	}
-	return &Board{data}, nil
+	return &Board{Board: data}, nil
 }
 
 func (s *Service) UpdateBoard(ctx context.Context, board *Board) (*Board, error) {
@@ -72,11 +98,41 @@ func (s *Service) UpdateBoard(ctx context.Context, board *Board) (*Board, error)
 	if err != nil {
 		return nil, err
 	}
-	return &Board{data}, nil
+	return &Board{Board: data, DefaultTags: board.DefaultTags}, nil
 }
 
+// SetBoardDefaultTags replaces the tags new items of board start with.
+func (s *Service) SetBoardDefaultTags(ctx context.Context, board *Board, tags []string) (*Board, error) {
+	tags, err := NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	err = s.withTx(ctx, func(q *repository.Queries) error {
+		if err := q.ClearBoardDefaultTags(ctx, board.ID); err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			err := q.AddBoardDefaultTag(ctx, repository.AddBoardDefaultTagParams{
+				BoardID: board.ID,
+				Tag:     tag,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(tags)
+	return &Board{Board: board.Board, DefaultTags: tags}, nil
+}
+
+// DeleteBoard moves board to the trash. Its items stay untouched and come back
+// when the board is restored.
 func (s *Service) DeleteBoard(ctx context.Context, board *Board) error {
-	return s.Repo.DeleteBoardByID(ctx, board.ID)
+	return s.Repo.SoftDeleteBoardByID(ctx, board.ID)
 }
 
 // ListItemsByBoard uses the aggregated JSON query and unmarshals the tags.
@@ -97,19 +153,61 @@ func (s *Service) ListItemsByBoard(ctx context.Context, board *Board) (*[]Item,
 				Completed:     v.Completed,
 				CreatedAt:     v.CreatedAt,
 				LastUpdatedAt: v.LastUpdatedAt,
+				DueAt:         v.DueAt,
+				Priority:      v.Priority,
+				Recurrence:    v.Recurrence,
+				Position:      v.Position,
 			},
-			Tags: tags,
+			Tags:          tags,
+			SubtasksTotal: v.SubtasksTotal,
+			SubtasksDone:  v.SubtasksDone,
+			BlockedBy:     v.BlockedBy,
+			Dependents:    v.Dependents,
 		}
 	}
 	return &items, nil
 }
 
+// GetItem returns the item with id. Items in the trash, or on a board in the
+// trash, are reported as sql.ErrNoRows.
+func (s *Service) GetItem(ctx context.Context, id int64) (*Item, error) {
+	v, err := s.Repo.GetActiveItemByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &Item{
+		Item: repository.Item{
+			ID:            v.ID,
+			BoardID:       v.BoardID,
+			Title:         v.Title,
+			Description:   v.Description,
+			Completed:     v.Completed,
+			CreatedAt:     v.CreatedAt,
+			LastUpdatedAt: v.LastUpdatedAt,
+			DueAt:         v.DueAt,
+			Priority:      v.Priority,
+			Recurrence:    v.Recurrence,
+			Position:      v.Position,
+		},
+		Tags:          unmarshalTags(v.Tags),
+		SubtasksTotal: v.SubtasksTotal,
+		SubtasksDone:  v.SubtasksDone,
+		BlockedBy:     v.BlockedBy,
+		Dependents:    v.Dependents,
+	}, nil
+}
+
 // ListItemsByTag uses the updated aggregated query and unmarshals the tags.
 func (s *Service) ListItemsByTag(ctx context.Context, tag string) (*[]Item, error) {
 	data, err := s.Repo.ListItemsByTag(ctx, tag)
 	if err != nil {
 		return nil, err
 	}
+	items := tagRowsToItems(data)
+	return &items, nil
+}
+
+func tagRowsToItems(data []repository.ListItemsByTagRow) []Item {
 	items := make([]Item, len(data))
 	for i, v := range data {
 		tags := unmarshalTags(v.Tags)
@@ -122,68 +220,189 @@ func (s *Service) ListItemsByTag(ctx context.Context, tag string) (*[]Item, erro
 				Completed:     v.Completed,
 				CreatedAt:     v.CreatedAt,
 				LastUpdatedAt: v.LastUpdatedAt,
+				DueAt:         v.DueAt,
+				Priority:      v.Priority,
+				Recurrence:    v.Recurrence,
+				Position:      v.Position,
 			},
-			Tags: tags,
+			Tags:          tags,
+			SubtasksTotal: v.SubtasksTotal,
+			SubtasksDone:  v.SubtasksDone,
+			BlockedBy:     v.BlockedBy,
+			Dependents:    v.Dependents,
 		}
 	}
-	return &items, nil
+	return items
 }
 
-func (s *Service) CreateItem(ctx context.Context, board *Board, title string, description string) (*Item, error) {
-	params := repository.CreateItemParams{
-		BoardID:     board.ID,
+// CreateItem creates an item in board tagged with the default tags of the
+// board. A nil dueAt creates an item without a due date.
+func (s *Service) CreateItem(
+	ctx context.Context,
+	board *Board,
+	title string,
+	description string,
+	dueAt *time.Time,
+) (*Item, error) {
+	var item *Item
+	err := s.withTx(ctx, func(q *repository.Queries) error {
+		var err error
+		item, err = createItem(ctx, q, board.ID, title, description, dueAt)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// CreateItemFrom creates an item in board with the fields and checklist of
+// item in one transaction. The tags of item are added to the default tags of
+// the board.
+func (s *Service) CreateItemFrom(ctx context.Context, board *Board, item *Item) (*Item, error) {
+	var created *Item
+	err := s.withTx(ctx, func(q *repository.Queries) error {
+		var err error
+		created, err = createItem(ctx, q, board.ID, item.Title, item.Description, item.DueAt)
+		if err != nil {
+			return err
+		}
+		if item.Completed || item.Priority != int64(PriorityNone) || item.Recurrence != "" || len(item.Tags) > 0 {
+			created.Completed = item.Completed
+			created.Priority = item.Priority
+			created.Recurrence = item.Recurrence
+			created.Tags = append(created.Tags, item.Tags...)
+			if created, err = updateItem(ctx, q, created); err != nil {
+				return err
+			}
+		}
+		for _, st := range item.Subtasks {
+			var subtask *Subtask
+			if subtask, err = createSubtask(ctx, q, created.ID, st.Title); err != nil {
+				return err
+			}
+			created.SubtasksTotal++
+			if !st.Completed {
+				continue
+			}
+			_, err = q.UpdateSubtaskByID(ctx, repository.UpdateSubtaskByIDParams{
+				Title:     subtask.Title,
+				Completed: true,
+				ID:        subtask.ID,
+			})
+			if err != nil {
+				return err
+			}
+			created.SubtasksDone++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// createItem creates an item in the board with boardID using q, tagged with
+// the default tags of the board.
+func createItem(
+	ctx context.Context,
+	q *repository.Queries,
+	boardID int64,
+	title string,
+	description string,
+	dueAt *time.Time,
+) (*Item, error) {
+	data, err := q.CreateItem(ctx, repository.CreateItemParams{
+		BoardID:     boardID,
 		Title:       title,
 		Description: description,
+		DueAt:       dueAt,
+	})
+	if err != nil {
+		return nil, err
 	}
-	data, err := s.Repo.CreateItem(ctx, params)
+	defaults, err := q.ListBoardDefaultTags(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
+	for _, tag := range defaults {
+		err = q.AddTagToItemByID(ctx, repository.AddTagToItemByIDParams{
+			ItemID: data.ID,
+			Tag:    tag,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &Item{
 		Item: data,
-		Tags: []string{},
+		Tags: append([]string{}, defaults...),
 	}, nil
 }
 
 func (s *Service) UpdateItem(ctx context.Context, item *Item) (*Item, error) {
+	var updated *Item
+	err := s.withTx(ctx, func(q *repository.Queries) error {
+		var err error
+		updated, err = updateItem(ctx, q, item)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// updateItem saves the fields and tags of item using q.
+func updateItem(ctx context.Context, q *repository.Queries, item *Item) (*Item, error) {
 	params := repository.UpdateItemByIDParams{
 		Title:       item.Title,
 		Description: item.Description,
 		Completed:   item.Completed,
+		DueAt:       item.DueAt,
+		Priority:    item.Priority,
 		ID:          item.ID,
 	}
 
-	emptyTags := false
-	for _, tag := range item.Tags {
-		if len(tag) == 0 {
-			emptyTags = true
-			break
-		}
+	tags, err := NormalizeTags(item.Tags)
+	if err != nil {
+		return nil, err
 	}
-	if emptyTags {
-		return nil, errors.New("tag must not be empty")
+
+	if !Priority(item.Priority).Valid() {
+		return nil, fmt.Errorf("priority must be between %d and %d", PriorityNone, PriorityUrgent)
 	}
 
-	data, err := s.Repo.UpdateItemByID(ctx, params)
+	recurrence, err := ParseRecurrence(item.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	params.Recurrence = recurrence.String()
+
+	data, err := q.UpdateItemByID(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	// Synchronize tags.
-	existingTags := s.listTagsByItemID(ctx, data.ID)
+	existingTags, err := q.ListTagsByItemID(ctx, data.ID)
+	if err != nil {
+		return nil, err
+	}
 	existingTagsMap := make(map[string]struct{}, len(existingTags))
 	for _, t := range existingTags {
 		existingTagsMap[t] = struct{}{}
 	}
-	newTagsMap := make(map[string]struct{}, len(item.Tags))
-	for _, t := range item.Tags {
+	newTagsMap := make(map[string]struct{}, len(tags))
+	for _, t := range tags {
 		newTagsMap[t] = struct{}{}
 	}
 
 	// Remove tags that are not in the updated item.
 	for _, t := range existingTags {
 		if _, found := newTagsMap[t]; !found {
-			err = s.Repo.RemoveTagFromItemByID(ctx, repository.RemoveTagFromItemByIDParams{
+			err = q.RemoveTagFromItemByID(ctx, repository.RemoveTagFromItemByIDParams{
 				ItemID: data.ID,
 				Tag:    t,
 			})
@@ -194,9 +413,9 @@ func (s *Service) UpdateItem(ctx context.Context, item *Item) (*Item, error) {
 	}
 
 	// Add new tags that are missing in the database.
-	for _, t := range item.Tags {
+	for _, t := range tags {
 		if _, found := existingTagsMap[t]; !found {
-			err = s.Repo.AddTagToItemByID(ctx, repository.AddTagToItemByIDParams{
+			err = q.AddTagToItemByID(ctx, repository.AddTagToItemByIDParams{
 				ItemID: data.ID,
 				Tag:    t,
 			})
@@ -207,13 +426,93 @@ func (s *Service) UpdateItem(ctx context.Context, item *Item) (*Item, error) {
 	}
 
 	return &Item{
-		Item: data,
-		Tags: item.Tags, // Return the updated tags.
+		Item:          data,
+		Tags:          tags, // Return the updated tags.
+		SubtasksTotal: item.SubtasksTotal,
+		SubtasksDone:  item.SubtasksDone,
+		BlockedBy:     item.BlockedBy,
+		Dependents:    item.Dependents,
 	}, nil
 }
 
+// ToggleItem flips the completion state of item. Completing a recurring item
+// creates its next occurrence in the same board with the same tags, and
+// returns it as next. The completed item is kept as history without the rule,
+// so un-completing and completing it again does not create duplicates. The
+// occurrence is created in the same transaction as the completion.
+func (s *Service) ToggleItem(ctx context.Context, item *Item) (*Item, *Item, error) {
+	toggled := *item
+	toggled.Completed = !item.Completed
+
+	recurrence, err := ParseRecurrence(item.Recurrence)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !toggled.Completed || recurrence.Kind == RecurNone {
+		updated, updateErr := s.UpdateItem(ctx, &toggled)
+		return updated, nil, updateErr
+	}
+
+	now := time.Now()
+	base := now
+	if item.DueAt != nil {
+		base = item.DueAt.In(time.Local)
+	}
+	// Occurrences missed while the item was overdue are skipped.
+	due := recurrence.Next(base)
+	for !due.After(now) {
+		due = recurrence.Next(due)
+	}
+
+	var updated, next *Item
+	err = s.withTx(ctx, func(q *repository.Queries) error {
+		var txErr error
+		next, txErr = createItem(ctx, q, item.BoardID, item.Title, item.Description, &due)
+		if txErr != nil {
+			return txErr
+		}
+		next.Tags = item.Tags
+		next.Priority = item.Priority
+		next.Recurrence = item.Recurrence
+		if next, txErr = updateItem(ctx, q, next); txErr != nil {
+			return txErr
+		}
+		toggled.Recurrence = ""
+		updated, txErr = updateItem(ctx, q, &toggled)
+		return txErr
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, next, nil
+}
+
+// MoveItem moves item to the end of targetBoard, keeping its id, timestamps,
+// tags and checklist.
+func (s *Service) MoveItem(ctx context.Context, item *Item, targetBoard *Board) (*Item, error) {
+	if item.BoardID == targetBoard.ID {
+		return nil, fmt.Errorf("item is already on board \"%s\"", targetBoard.Name)
+	}
+	data, err := s.Repo.MoveItemToBoardByID(ctx, repository.MoveItemToBoardByIDParams{
+		BoardID: targetBoard.ID,
+		ID:      item.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Item{
+		Item:          data,
+		Tags:          item.Tags,
+		SubtasksTotal: item.SubtasksTotal,
+		SubtasksDone:  item.SubtasksDone,
+		BlockedBy:     item.BlockedBy,
+		Dependents:    item.Dependents,
+	}, nil
+}
+
+// DeleteItem moves item to the trash.
 func (s *Service) DeleteItem(ctx context.Context, item *Item) error {
-	return s.Repo.DeleteItemByID(ctx, item.ID)
+	return s.Repo.SoftDeleteItemByID(ctx, item.ID)
 }
 
 // ListTags returns all tags.
@@ -221,8 +520,64 @@ func (s *Service) ListTags(ctx context.Context) ([]string, error) {
 	return s.Repo.ListTags(ctx)
 }
 
+// ListTagUsage returns every tag with the number of items carrying it, most
+// used first.
+func (s *Service) ListTagUsage(ctx context.Context) ([]TagUsage, error) {
+	data, err := s.Repo.ListTagUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage := make([]TagUsage, len(data))
+	for i, u := range data {
+		usage[i] = TagUsage{u}
+	}
+	return usage, nil
+}
+
+// ListTagSummaries returns every tag and each of its parents with the open and
+// completed items of its subtree, ordered by name.
+func (s *Service) ListTagSummaries(ctx context.Context) ([]TagSummary, error) {
+	data, err := s.Repo.ListTagSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]TagSummary, len(data))
+	for i, v := range data {
+		summaries[i] = TagSummary{
+			Tag:            v.Tag,
+			Open:           v.Open,
+			Done:           v.Total - v.Open,
+			LastActivityAt: parseTimestamp(v.LastActivityAt),
+		}
+	}
+	return summaries, nil
+}
+
+// DeleteTag removes tag and the tags below it, such as "work/infra" below
+// "work", from every item and board defaults along with their metadata.
 func (s *Service) DeleteTag(ctx context.Context, tag string) error {
-	return s.Repo.DeleteTag(ctx, tag)
+	return s.withTx(ctx, func(q *repository.Queries) error {
+		subtree, err := q.ListTagSubtree(ctx, tag)
+		if err != nil {
+			return err
+		}
+		// A parent that no item carries directly is not in its own subtree.
+		if !slices.Contains(subtree, tag) {
+			subtree = append(subtree, tag)
+		}
+		for _, t := range subtree {
+			if err = q.DeleteTag(ctx, t); err != nil {
+				return err
+			}
+			if err = q.DeleteBoardDefaultTag(ctx, t); err != nil {
+				return err
+			}
+			if err = q.DeleteTagMetadata(ctx, t); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (s *Service) CountItemsByTag(ctx context.Context, tag string) (int64, error) {
@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestActivityLog(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	inbox := mustCreateBoard(ctx, t, svc, "Inbox")
+	work := mustCreateBoard(ctx, t, svc, "Work")
+	item := mustCreateItem(ctx, t, svc, inbox, "draft", "")
+
+	item.Title = "report"
+	item.Description = "quarterly"
+	item.Tags = []string{"q3"}
+	item = mustUpdateItem(ctx, t, svc, item)
+	item.Tags = []string{}
+	item = mustUpdateItem(ctx, t, svc, item)
+	item, _, err := svc.ToggleItem(ctx, item)
+	require.NoError(t, err)
+	item, err = svc.MoveItem(ctx, item, work)
+	require.NoError(t, err)
+	mustDeleteItem(ctx, t, svc, item)
+	require.NoError(t, svc.RestoreItem(ctx, item))
+	require.NoError(t, svc.PurgeItem(ctx, item))
+
+	history := mustListActivity(ctx, t, svc, service.ActivityFilter{ItemID: item.ID})
+	assert.Equal(t, []string{
+		service.ActionPurged,
+		service.ActionRestored,
+		service.ActionDeleted,
+		service.ActionMoved,
+		service.ActionCompleted,
+		service.ActionUntagged,
+		service.ActionTagged,
+		service.ActionRenamed,
+		service.ActionEdited,
+		service.ActionCreated,
+	}, actions(history))
+	assert.Equal(t, `moved "report" from "Inbox"`, history[3].Summary())
+	assert.Equal(t, `renamed item "report" (was "draft")`, history[7].Summary())
+	assert.Equal(t, `purged item "report"`, history[0].Summary())
+
+	inboxHistory := mustListActivity(ctx, t, svc, service.ActivityFilter{BoardID: inbox.ID})
+	assert.Equal(t, service.ActionCreated, inboxHistory[len(inboxHistory)-1].Action)
+	assert.True(t, inboxHistory[len(inboxHistory)-1].IsBoardEvent())
+
+	limited := mustListActivity(ctx, t, svc, service.ActivityFilter{Limit: 2})
+	assert.Len(t, limited, 2)
+
+	future := mustListActivity(ctx, t, svc, service.ActivityFilter{Since: time.Now().Add(time.Hour)})
+	assert.Empty(t, future)
+	past := mustListActivity(ctx, t, svc, service.ActivityFilter{Until: time.Now().Add(-time.Hour)})
+	assert.Empty(t, past)
+	all := mustListActivity(ctx, t, svc, service.ActivityFilter{
+		Since: time.Now().Add(-time.Hour),
+		Until: time.Now().Add(time.Hour),
+	})
+	assert.Len(t, all, len(history)+2, "item events plus both board creations")
+}
+
+func TestActivityLogSkipsCascadedEvents(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Inbox")
+	item := mustCreateItem(ctx, t, svc, board, "task", "")
+	item.Tags = []string{"work"}
+	mustUpdateItem(ctx, t, svc, item)
+	require.NoError(t, svc.PurgeBoard(ctx, board))
+
+	history := mustListActivity(ctx, t, svc, service.ActivityFilter{BoardID: board.ID})
+	assert.Equal(t, []string{
+		service.ActionPurged,
+		service.ActionTagged,
+		service.ActionCreated,
+		service.ActionCreated,
+	}, actions(history))
+	assert.True(t, history[0].IsBoardEvent())
+}
+
+func mustListActivity(
+	ctx context.Context,
+	t *testing.T,
+	svc *service.Service,
+	filter service.ActivityFilter,
+) []service.Activity {
+	t.Helper()
+	activity, err := svc.ListActivity(ctx, filter)
+	require.NoError(t, err)
+	return *activity
+}
+
+func actions(activity []service.Activity) []string {
+	names := make([]string, len(activity))
+	for i, a := range activity {
+		names[i] = a.Action
+	}
+	return names
+}
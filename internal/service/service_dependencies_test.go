@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestBlockers(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	inbox := mustCreateBoard(ctx, t, svc, "Inbox")
+	work := mustCreateBoard(ctx, t, svc, "Work")
+	ship := mustCreateItem(ctx, t, svc, inbox, "ship", "")
+	build := mustCreateItem(ctx, t, svc, work, "build", "")
+	design := mustCreateItem(ctx, t, svc, work, "design", "")
+
+	require.NoError(t, svc.AddBlocker(ctx, ship, build))
+	require.NoError(t, svc.AddBlocker(ctx, ship, design))
+	require.NoError(t, svc.AddBlocker(ctx, build, design))
+	require.NoError(t, svc.AddBlocker(ctx, build, design), "adding twice is a no-op")
+
+	rejected := []struct {
+		name    string
+		item    *service.Item
+		blocker *service.Item
+	}{
+		{name: "self dependency", item: ship, blocker: ship},
+		{name: "direct cycle", item: build, blocker: ship},
+		{name: "transitive cycle", item: design, blocker: ship},
+	}
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, svc.AddBlocker(ctx, tt.item, tt.blocker))
+		})
+	}
+
+	ids, err := svc.ListBlockerIDs(ctx, ship)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{build.ID, design.ID}, ids)
+	assert.Equal(t, int64(2), blockedBy(t, svc, inbox, ship.ID))
+
+	// Completed blockers no longer count.
+	_, _, err = svc.ToggleItem(ctx, design)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), blockedBy(t, svc, inbox, ship.ID))
+	assert.Equal(t, int64(0), blockedBy(t, svc, work, build.ID))
+
+	require.NoError(t, svc.RemoveBlocker(ctx, ship, build))
+	assert.Equal(t, int64(0), blockedBy(t, svc, inbox, ship.ID))
+}
+
+func blockedBy(t *testing.T, svc *service.Service, board *service.Board, id int64) int64 {
+	t.Helper()
+	for _, item := range *mustListItemsByBoard(testutil.MustContext(), t, svc, board) {
+		if item.ID == id {
+			return item.BlockedBy
+		}
+	}
+	t.Fatalf("item %d not found", id)
+	return 0
+}
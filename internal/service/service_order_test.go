@@ -0,0 +1,238 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestItemDueDateRoundTrip(t *testing.T) {
+	due := time.Date(2026, time.March, 14, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		created *time.Time
+		updated *time.Time
+	}{
+		{name: "set due date on create and clear it on update", created: &due, updated: nil},
+		{name: "set due date on update", created: nil, updated: &due},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Due")
+			item, err := svc.CreateItem(ctx, board, "task", "", tt.created)
+			require.NoError(t, err)
+			assertDueAt(t, tt.created, item.DueAt)
+
+			item.DueAt = tt.updated
+			item = mustUpdateItem(ctx, t, svc, item)
+			assertDueAt(t, tt.updated, item.DueAt)
+
+			items := mustListItemsByBoard(ctx, t, svc, board)
+			require.Len(t, *items, 1)
+			assertDueAt(t, tt.updated, (*items)[0].DueAt)
+		})
+	}
+}
+
+func TestSortItems(t *testing.T) {
+	base := time.Date(2026, time.March, 14, 0, 0, 0, 0, time.UTC)
+	at := func(days int) *time.Time {
+		d := base.AddDate(0, 0, days)
+		return &d
+	}
+	newItem := func(id int64, due *time.Time, priority service.Priority, position int64) service.Item {
+		var item service.Item
+		item.ID = id
+		item.Position = position
+		item.CreatedAt = base
+		item.DueAt = due
+		item.Priority = int64(priority)
+		return item
+	}
+
+	tests := []struct {
+		name    string
+		order   service.ItemOrder
+		wantIDs []int64
+	}{
+		{name: "created order", order: service.OrderByCreated, wantIDs: []int64{1, 2, 3, 4}},
+		{name: "due date order puts undated last", order: service.OrderByDueDate, wantIDs: []int64{3, 1, 2, 4}},
+		{name: "priority order puts most urgent first", order: service.OrderByPriority, wantIDs: []int64{2, 4, 1, 3}},
+		{name: "manual order follows positions", order: service.OrderByPosition, wantIDs: []int64{2, 3, 4, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := []service.Item{
+				newItem(4, nil, service.PriorityHigh, 2),
+				newItem(2, at(2), service.PriorityUrgent, 0),
+				newItem(1, at(1), service.PriorityNone, 3),
+				newItem(3, at(-1), service.PriorityNone, 1),
+			}
+			service.SortItems(items, tt.order)
+
+			ids := make([]int64, len(items))
+			for i, item := range items {
+				ids[i] = item.ID
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+
+	t.Run("next cycles through orders", func(t *testing.T) {
+		assert.Equal(t, service.OrderByDueDate, service.OrderByCreated.Next())
+		assert.Equal(t, service.OrderByPriority, service.OrderByDueDate.Next())
+		assert.Equal(t, service.OrderByPosition, service.OrderByPriority.Next())
+		assert.Equal(t, service.OrderByCreated, service.OrderByPosition.Next())
+	})
+}
+
+func TestSortTags(t *testing.T) {
+	base := time.Date(2026, time.March, 14, 0, 0, 0, 0, time.UTC)
+	summary := func(tag string, open int64, days int) service.TagSummary {
+		s := service.TagSummary{Tag: tag, Open: open}
+		if days >= 0 {
+			at := base.AddDate(0, 0, days)
+			s.LastActivityAt = &at
+		}
+		return s
+	}
+
+	tests := []struct {
+		name  string
+		order service.TagOrder
+		want  []string
+	}{
+		{
+			name:  "name order keeps children after their parent",
+			order: service.OrderTagsByName,
+			want:  []string{"home", "work", "work/infra", "work/ui", "work-log"},
+		},
+		{
+			name:  "most open order sorts siblings within their parent",
+			order: service.OrderTagsByOpen,
+			want:  []string{"work", "work/ui", "work/infra", "home", "work-log"},
+		},
+		{
+			name:  "most recent order puts inactive tags last",
+			order: service.OrderTagsByRecent,
+			want:  []string{"work-log", "work", "work/infra", "work/ui", "home"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags := []service.TagSummary{
+				summary("work/ui", 3, 1),
+				summary("work-log", 0, 5),
+				summary("home", 1, -1),
+				summary("work", 4, 3),
+				summary("work/infra", 1, 3),
+			}
+			service.SortTags(tags, tt.order)
+
+			got := make([]string, len(tags))
+			for i, tag := range tags {
+				got[i] = tag.Tag
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("next cycles through orders", func(t *testing.T) {
+		assert.Equal(t, service.OrderTagsByOpen, service.OrderTagsByName.Next())
+		assert.Equal(t, service.OrderTagsByRecent, service.OrderTagsByOpen.Next())
+		assert.Equal(t, service.OrderTagsByName, service.OrderTagsByRecent.Next())
+	})
+}
+
+func TestItemPriority(t *testing.T) {
+	tests := []struct {
+		name     string
+		priority service.Priority
+		wantErr  string
+	}{
+		{name: "stores valid priority", priority: service.PriorityHigh},
+		{name: "rejects unknown priority", priority: service.PriorityUrgent + 1, wantErr: "priority must be between"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Priorities")
+			item := mustCreateItem(ctx, t, svc, board, "task", "")
+			assert.Equal(t, int64(service.PriorityNone), item.Priority)
+
+			item.Priority = int64(tt.priority)
+			updated, err := svc.UpdateItem(ctx, item)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(tt.priority), updated.Priority)
+
+			items := mustListItemsByBoard(ctx, t, svc, board)
+			require.Len(t, *items, 1)
+			assert.Equal(t, int64(tt.priority), (*items)[0].Priority)
+		})
+	}
+
+	t.Run("next wraps after urgent", func(t *testing.T) {
+		assert.Equal(t, service.PriorityLow, service.PriorityNone.Next())
+		assert.Equal(t, service.PriorityNone, service.PriorityUrgent.Next())
+		assert.Equal(t, "!!!", service.PriorityHigh.Marker())
+	})
+}
+
+func assertDueAt(t *testing.T, want, got *time.Time) {
+	t.Helper()
+	if want == nil {
+		assert.Nil(t, got)
+		return
+	}
+	require.NotNil(t, got)
+	assert.True(t, want.Equal(*got), "want %v, got %v", want, got)
+}
+
+func TestParsePriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    service.Priority
+		wantErr bool
+	}{
+		{name: "name", input: "high", want: service.PriorityHigh},
+		{name: "name is case-insensitive", input: " Urgent ", want: service.PriorityUrgent},
+		{name: "level", input: "1", want: service.PriorityLow},
+		{name: "level out of range", input: "5", wantErr: true},
+		{name: "unknown name", input: "asap", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := service.ParsePriority(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestMoveBoards(t *testing.T) {
+	tests := []struct {
+		name      string
+		move      func(*service.Service, *service.Board) error
+		board     int
+		wantOrder []string
+	}{
+		{
+			name: "move up",
+			move: func(svc *service.Service, b *service.Board) error {
+				return svc.MoveBoardUp(testutil.MustContext(), b)
+			},
+			board:     2,
+			wantOrder: []string{"one", "three", "two"},
+		},
+		{
+			name: "move up at the top is a no-op",
+			move: func(svc *service.Service, b *service.Board) error {
+				return svc.MoveBoardUp(testutil.MustContext(), b)
+			},
+			board:     0,
+			wantOrder: []string{"one", "two", "three"},
+		},
+		{
+			name: "move down",
+			move: func(svc *service.Service, b *service.Board) error {
+				return svc.MoveBoardDown(testutil.MustContext(), b)
+			},
+			board:     0,
+			wantOrder: []string{"two", "one", "three"},
+		},
+		{
+			name: "move to index clamps",
+			move: func(svc *service.Service, b *service.Board) error {
+				return svc.MoveBoardTo(testutil.MustContext(), b, 10)
+			},
+			board:     0,
+			wantOrder: []string{"two", "three", "one"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			var created []*service.Board
+			for _, name := range []string{"one", "two", "three"} {
+				created = append(created, mustCreateBoard(ctx, t, svc, name))
+			}
+
+			require.NoError(t, tt.move(svc, created[tt.board]))
+
+			boards, err := svc.ListBoards(ctx)
+			require.NoError(t, err)
+			names := make([]string, len(*boards))
+			for i, b := range *boards {
+				names[i] = b.Name
+			}
+			assert.Equal(t, tt.wantOrder, names)
+		})
+	}
+}
+
+func TestMoveItems(t *testing.T) {
+	tests := []struct {
+		name      string
+		move      func(*service.Service, *service.Item) error
+		item      int
+		wantOrder []string
+	}{
+		{
+			name: "move up",
+			move: func(svc *service.Service, i *service.Item) error {
+				return svc.MoveItemUp(testutil.MustContext(), i)
+			},
+			item:      1,
+			wantOrder: []string{"b", "a", "c"},
+		},
+		{
+			name: "move down at the bottom is a no-op",
+			move: func(svc *service.Service, i *service.Item) error {
+				return svc.MoveItemDown(testutil.MustContext(), i)
+			},
+			item:      2,
+			wantOrder: []string{"a", "b", "c"},
+		},
+		{
+			name: "move to index",
+			move: func(svc *service.Service, i *service.Item) error {
+				return svc.MoveItemTo(testutil.MustContext(), i, 0)
+			},
+			item:      2,
+			wantOrder: []string{"c", "a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Ordered")
+			other := mustCreateBoard(ctx, t, svc, "Other")
+			mustCreateItem(ctx, t, svc, other, "elsewhere", "")
+			var created []*service.Item
+			for _, title := range []string{"a", "b", "c"} {
+				created = append(created, mustCreateItem(ctx, t, svc, board, title, ""))
+			}
+			before, err := svc.ListBoards(ctx)
+			require.NoError(t, err)
+
+			require.NoError(t, tt.move(svc, created[tt.item]))
+
+			items := mustListItemsByBoard(ctx, t, svc, board)
+			titles := make([]string, len(*items))
+			for i, item := range *items {
+				titles[i] = item.Title
+			}
+			assert.Equal(t, tt.wantOrder, titles)
+
+			after, err := svc.ListBoards(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, *before, *after, "reordering must not touch boards")
+		})
+	}
+}
+
+func TestMoveItemToBoardKeepsIdentity(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	source := mustCreateBoard(ctx, t, svc, "Source")
+	target := mustCreateBoard(ctx, t, svc, "Target")
+	mustCreateItem(ctx, t, svc, target, "existing", "")
+	item := mustCreateItem(ctx, t, svc, source, "task", "desc")
+	item.Tags = []string{"work"}
+	item = mustUpdateItem(ctx, t, svc, item)
+	_, err := svc.AddSubtask(ctx, item, "step")
+	require.NoError(t, err)
+
+	moved, err := svc.MoveItem(ctx, item, target)
+	require.NoError(t, err)
+	assert.Equal(t, item.ID, moved.ID)
+	assert.Equal(t, target.ID, moved.BoardID)
+	assert.True(t, item.CreatedAt.Equal(moved.CreatedAt))
+
+	assert.Empty(t, *mustListItemsByBoard(ctx, t, svc, source))
+	items := mustListItemsByBoard(ctx, t, svc, target)
+	require.Len(t, *items, 2)
+	last := (*items)[1]
+	assert.Equal(t, item.ID, last.ID)
+	assert.Equal(t, []string{"work"}, last.Tags)
+	assert.Equal(t, int64(1), last.SubtasksTotal)
+
+	_, err = svc.MoveItem(ctx, moved, target)
+	require.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestParseQuickAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    service.QuickAdd
+		wantErr string
+	}{
+		{name: "title only", input: "  buy   milk ", want: service.QuickAdd{Title: "buy milk"}},
+		{
+			name:  "every part",
+			input: "Pay rent #finance #home @Personal -- rent for november",
+			want: service.QuickAdd{
+				Title:       "Pay rent",
+				Description: "rent for november",
+				Board:       "Personal",
+				Tags:        []string{"finance", "home"},
+			},
+		},
+		{
+			name:  "tags and board anywhere in the title",
+			input: "#Work call @Inbox bob #work #Day_Job",
+			want:  service.QuickAdd{Title: "call bob", Board: "Inbox", Tags: []string{"work", "day_job"}},
+		},
+		{
+			name:  "quoted board name",
+			input: `plan trip @"Side Projects" #travel`,
+			want:  service.QuickAdd{Title: "plan trip", Board: "Side Projects", Tags: []string{"travel"}},
+		},
+		{
+			name:  "description keeps its markers",
+			input: "review -- see #42 @bob -- later",
+			want:  service.QuickAdd{Title: "review", Description: "see #42 @bob -- later"},
+		},
+		{
+			name:  "escaped markers stay in the title",
+			input: `fix bug \#42 for \@bob \-- today`,
+			want:  service.QuickAdd{Title: "fix bug #42 for @bob -- today"},
+		},
+		{
+			name:  "lone markers and inner dashes are words",
+			input: "call # @ re--do",
+			want:  service.QuickAdd{Title: "call # @ re--do"},
+		},
+		{name: "empty", input: "  ", wantErr: "item title must not be empty"},
+		{name: "only tags", input: "#a @Inbox -- desc", wantErr: "item title must not be empty"},
+		{name: "two boards", input: "task @Inbox @Work", wantErr: `more than one board given: "Inbox" and "Work"`},
+		{name: "unterminated quote", input: `task @"Side Projects`, wantErr: "unterminated board name"},
+		{name: "empty quoted board", input: `task @" "`, wantErr: "board name must not be empty"},
+		{name: "invalid tag", input: "task #a+b", wantErr: "invalid tag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := service.ParseQuickAdd(tt.input)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQuickAddString(t *testing.T) {
+	q, err := service.ParseQuickAdd("Pay rent #finance #home @Personal -- rent for november")
+	require.NoError(t, err)
+	assert.Equal(t, `"Pay rent" in "Personal" #finance #home -- rent for november`, q.String())
+	assert.Equal(t, `"buy milk"`, service.QuickAdd{Title: "buy milk"}.String())
+}
+
+func TestCreateQuickAdd(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+	ctx := testutil.MustContext()
+
+	board, err := svc.CreateBoard(ctx, "Personal")
+	require.NoError(t, err)
+	board, err = svc.SetBoardDefaultTags(ctx, board, []string{"home"})
+	require.NoError(t, err)
+
+	q, err := service.ParseQuickAdd("Pay rent #finance #home -- rent for november")
+	require.NoError(t, err)
+	item, err := svc.CreateQuickAdd(ctx, board, q)
+	require.NoError(t, err)
+	assert.Equal(t, board.ID, item.BoardID)
+	assert.Equal(t, "Pay rent", item.Title)
+	assert.Equal(t, "rent for november", item.Description)
+	assert.ElementsMatch(t, []string{"home", "finance"}, item.Tags)
+
+	item, err = svc.CreateQuickAdd(ctx, board, service.QuickAdd{Title: "water plants"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"home"}, item.Tags)
+
+	// An invalid tag rolls the item back.
+	_, err = svc.CreateQuickAdd(ctx, board, service.QuickAdd{Title: "call mom", Tags: []string{"a+b"}})
+	require.Error(t, err)
+	assert.Len(t, *mustListItemsByBoard(ctx, t, svc, board), 2)
+}
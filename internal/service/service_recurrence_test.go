@@ -0,0 +1,190 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      string
+		wantKind  service.RecurrenceKind
+		wantRule  string
+		wantError bool
+	}{
+		{name: "empty rule does not recur", rule: "  ", wantKind: service.RecurNone, wantRule: ""},
+		{name: "daily", rule: "Daily", wantKind: service.RecurDaily, wantRule: "daily"},
+		{name: "weekly without days", rule: "weekly", wantKind: service.RecurWeekly, wantRule: "weekly"},
+		{
+			name:     "weekly days are sorted and deduplicated",
+			rule:     "weekly friday,mon,fri",
+			wantKind: service.RecurWeekly,
+			wantRule: "weekly mon,fri",
+		},
+		{name: "monthly", rule: "monthly 31", wantKind: service.RecurMonthly, wantRule: "monthly 31"},
+		{name: "every n days", rule: "every 3 days", wantKind: service.RecurEveryNDays, wantRule: "every 3 days"},
+		{name: "every single day", rule: "every 1 day", wantKind: service.RecurEveryNDays, wantRule: "every 1 day"},
+		{name: "unknown weekday", rule: "weekly funday", wantError: true},
+		{name: "day of month out of range", rule: "monthly 32", wantError: true},
+		{name: "zero interval", rule: "every 0 days", wantError: true},
+		{name: "unknown rule", rule: "yearly", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := service.ParseRecurrence(tt.rule)
+			if tt.wantError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKind, r.Kind)
+			assert.Equal(t, tt.wantRule, r.String())
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	// 2026-01-30 is a Friday.
+	after := time.Date(2026, time.January, 30, 18, 30, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		rule string
+		want time.Time
+	}{
+		{name: "daily", rule: "daily", want: day(time.January, 31)},
+		{name: "every n days", rule: "every 3 days", want: day(time.February, 2)},
+		{name: "weekly without days", rule: "weekly", want: day(time.February, 6)},
+		{name: "weekly wraps to next week", rule: "weekly mon,wed", want: day(time.February, 2)},
+		{name: "weekly on same weekday", rule: "weekly fri", want: day(time.February, 6)},
+		{name: "monthly later this month", rule: "monthly 31", want: day(time.January, 31)},
+		{name: "monthly clamps to month end", rule: "monthly 30", want: day(time.February, 28)},
+		{name: "monthly next month", rule: "monthly 15", want: day(time.February, 15)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := service.ParseRecurrence(tt.rule)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.Next(after))
+		})
+	}
+}
+
+func TestToggleItemCreatesNextOccurrence(t *testing.T) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name       string
+		due        time.Time
+		recurrence string
+		wantNext   bool
+		wantDue    time.Time
+	}{
+		{name: "plain item is only toggled", due: today.AddDate(0, 0, 3), recurrence: "", wantNext: false},
+		{
+			name:       "recurring item regenerates",
+			due:        today.AddDate(0, 0, 3),
+			recurrence: "every 7 days",
+			wantNext:   true,
+			wantDue:    today.AddDate(0, 0, 10),
+		},
+		{
+			name:       "overdue item skips the missed occurrences",
+			due:        today.AddDate(0, 0, -20),
+			recurrence: "every 7 days",
+			wantNext:   true,
+			wantDue:    today.AddDate(0, 0, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Chores")
+			item, err := svc.CreateItem(ctx, board, "laundry", "whites", &tt.due)
+			require.NoError(t, err)
+			item.Tags = []string{"home"}
+			item.Priority = int64(service.PriorityHigh)
+			item.Recurrence = tt.recurrence
+			item = mustUpdateItem(ctx, t, svc, item)
+
+			done, next, err := svc.ToggleItem(ctx, item)
+			require.NoError(t, err)
+			assert.True(t, done.Completed)
+
+			items := mustListItemsByBoard(ctx, t, svc, board)
+			if !tt.wantNext {
+				assert.Nil(t, next)
+				assert.Len(t, *items, 1)
+				return
+			}
+
+			require.NotNil(t, next)
+			assert.Empty(t, done.Recurrence)
+			assert.NotEqual(t, item.ID, next.ID)
+			assert.False(t, next.Completed)
+			assert.Equal(t, item.Title, next.Title)
+			assert.Equal(t, item.Description, next.Description)
+			assert.Equal(t, item.Tags, next.Tags)
+			assert.Equal(t, item.Priority, next.Priority)
+			assert.Equal(t, tt.recurrence, next.Recurrence)
+			require.NotNil(t, next.DueAt)
+			assert.Equal(t, tt.wantDue, next.DueAt.In(time.Local))
+			assert.Len(t, *items, 2)
+
+			undone, again, err := svc.ToggleItem(ctx, done)
+			require.NoError(t, err)
+			assert.False(t, undone.Completed)
+			assert.Nil(t, again)
+		})
+	}
+}
+
+func TestToggleItemRollsBackNextOccurrence(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Chores")
+	item := mustCreateItem(ctx, t, svc, board, "laundry", "")
+	item.Recurrence = "daily"
+	item = mustUpdateItem(ctx, t, svc, item)
+
+	// The occurrence is created before the invalid priority is saved.
+	item.Priority = 99
+	_, _, err := svc.ToggleItem(ctx, item)
+	require.Error(t, err)
+
+	items := mustListItemsByBoard(ctx, t, svc, board)
+	require.Len(t, *items, 1)
+	assert.False(t, (*items)[0].Completed)
+	assert.Equal(t, "daily", (*items)[0].Recurrence)
+}
+
+func TestUpdateItemRejectsInvalidRecurrence(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Chores")
+	item := mustCreateItem(ctx, t, svc, board, "laundry", "")
+	item.Recurrence = "sometimes"
+	_, err := svc.UpdateItem(ctx, item)
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func subtaskTitles(subtasks []service.Subtask) []string {
+	titles := make([]string, len(subtasks))
+	for i, st := range subtasks {
+		titles[i] = st.Title
+	}
+	return titles
+}
+
+func TestSubtaskLifecycle(t *testing.T) {
+	tests := []struct {
+		name      string
+		titles    []string
+		moveFrom  int
+		moveTo    int
+		wantOrder []string
+	}{
+		{
+			name:      "move last subtask to the top",
+			titles:    []string{"one", "two", "three"},
+			moveFrom:  2,
+			moveTo:    0,
+			wantOrder: []string{"three", "one", "two"},
+		},
+		{
+			name:      "move first subtask past the end clamps",
+			titles:    []string{"one", "two", "three"},
+			moveFrom:  0,
+			moveTo:    10,
+			wantOrder: []string{"two", "three", "one"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Checklists")
+			item := mustCreateItem(ctx, t, svc, board, "project", "")
+
+			for _, title := range tt.titles {
+				_, err := svc.AddSubtask(ctx, item, title)
+				require.NoError(t, err)
+			}
+			subtasks, err := svc.ListSubtasks(ctx, item)
+			require.NoError(t, err)
+			assert.Equal(t, tt.titles, subtaskTitles(*subtasks))
+
+			toggled, err := svc.ToggleSubtask(ctx, &(*subtasks)[0])
+			require.NoError(t, err)
+			assert.True(t, toggled.Completed)
+
+			moved, err := svc.MoveSubtask(ctx, &(*subtasks)[tt.moveFrom], tt.moveTo)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOrder, subtaskTitles(*moved))
+
+			subtasks, err = svc.ListSubtasks(ctx, item)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOrder, subtaskTitles(*subtasks))
+
+			items, err := svc.ListItemsByBoard(ctx, board)
+			require.NoError(t, err)
+			require.Len(t, *items, 1)
+			assert.Equal(t, int64(len(tt.titles)), (*items)[0].SubtasksTotal)
+			assert.Equal(t, int64(1), (*items)[0].SubtasksDone)
+
+			require.NoError(t, svc.DeleteSubtask(ctx, &(*subtasks)[0]))
+			subtasks, err = svc.ListSubtasks(ctx, item)
+			require.NoError(t, err)
+			assert.Len(t, *subtasks, len(tt.titles)-1)
+		})
+	}
+}
+
+func TestSubtaskValidationAndCascade(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Checklists")
+	item := mustCreateItem(ctx, t, svc, board, "project", "")
+
+	_, err := svc.AddSubtask(ctx, item, "   ")
+	require.Error(t, err)
+
+	subtask, err := svc.AddSubtask(ctx, item, "step")
+	require.NoError(t, err)
+	subtask.Title = ""
+	_, err = svc.UpdateSubtask(ctx, subtask)
+	require.Error(t, err)
+
+	missing := *subtask
+	missing.ID++
+	_, err = svc.MoveSubtask(ctx, &missing, 0)
+	require.EqualError(t, err, "subtask not found")
+
+	require.NoError(t, svc.PurgeItem(ctx, item))
+	subtasks, err := svc.ListSubtasks(ctx, item)
+	require.NoError(t, err)
+	assert.Empty(t, *subtasks)
+}
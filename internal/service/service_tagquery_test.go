@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestParseTagQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr string
+	}{
+		{name: "single tag", query: "work", want: "work"},
+		{name: "keywords are case-insensitive", query: "work and not later", want: "work AND NOT later"},
+		{name: "and binds tighter than or", query: "a OR b AND c", want: "a OR b AND c"},
+		{name: "parentheses are kept where needed", query: "(home OR errands) AND NOT done", want: "(home OR errands) AND NOT done"},
+		{name: "redundant parentheses are dropped", query: "((work)) AND (urgent)", want: "work AND urgent"},
+		{name: "negated group", query: "NOT (a OR b)", want: "NOT (a OR b)"},
+		{name: "hierarchical tags", query: "work/infra OR home", want: "work/infra OR home"},
+		{name: "empty query", query: "  ", wantErr: "empty query: expected a tag"},
+		{name: "missing operand", query: "work AND", wantErr: "unexpected end of query at position 9: expected a tag"},
+		{name: "leading operator", query: "OR work", wantErr: "unexpected OR at position 1: expected a tag"},
+		{name: "missing operator", query: "work urgent", wantErr: "unexpected \"urgent\" at position 6: expected AND or OR"},
+		{name: "unclosed parenthesis", query: "(home OR errands", wantErr: "missing \")\" for \"(\" at position 1"},
+		{name: "unmatched closing parenthesis", query: "home)", wantErr: "unexpected \")\" at position 5: no matching \"(\""},
+		{name: "empty parentheses", query: "work AND ()", wantErr: "unexpected \")\" at position 11: expected a tag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := service.ParseTagQuery(tt.query)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query.String())
+		})
+	}
+}
+
+func TestListItemsByTagQuery(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Tags")
+	for title, tags := range map[string][]string{
+		"deploy":    {"work/infra", "urgent"},
+		"review":    {"work", "later"},
+		"groceries": {"errands"},
+		"clean":     {"home", "done"},
+		"untagged":  nil,
+	} {
+		item := mustCreateItem(ctx, t, svc, board, title, "")
+		item.Tags = tags
+		_ = mustUpdateItem(ctx, t, svc, item)
+	}
+	trashed := mustCreateItem(ctx, t, svc, board, "trashed", "")
+	trashed.Tags = []string{"work", "urgent"}
+	_ = mustUpdateItem(ctx, t, svc, trashed)
+	mustDeleteItem(ctx, t, svc, trashed)
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "tag matches its subtree", query: "work", want: []string{"deploy", "review"}},
+		{name: "and not", query: "work AND urgent AND NOT later", want: []string{"deploy"}},
+		{name: "grouped or", query: "(home OR errands) AND NOT done", want: []string{"groceries"}},
+		{name: "not matches untagged items", query: "NOT (work OR home OR errands)", want: []string{"untagged"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := svc.ListItemsByTagQuery(ctx, tt.query)
+			require.NoError(t, err)
+			titles := make([]string, len(*items))
+			for i, item := range *items {
+				titles[i] = item.Title
+			}
+			assert.ElementsMatch(t, tt.want, titles)
+		})
+	}
+
+	items, err := svc.ListItemsByTagQuery(ctx, "work AND urgent")
+	require.NoError(t, err)
+	require.Len(t, *items, 1)
+	assert.ElementsMatch(t, []string{"work/infra", "urgent"}, (*items)[0].Tags)
+
+	_, err = svc.ListItemsByTagQuery(ctx, "work AND")
+	require.Error(t, err)
+}
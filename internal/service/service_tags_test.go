@@ -1,11 +1,16 @@
 package service_test
 
 import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/rhajizada/donezo/internal/repository"
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/testutil"
 )
@@ -115,3 +120,574 @@ func TestUpdateItemRejectsEmptyTags(t *testing.T) {
 		})
 	}
 }
+
+func TestRenameAndMergeTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		apply     func(context.Context, *service.Service) error
+		wantErr   string
+		wantTags  []string
+		wantItemA []string
+		wantItemB []string
+	}{
+		{
+			name: "rename retags every item",
+			apply: func(ctx context.Context, svc *service.Service) error {
+				return svc.RenameTag(ctx, "wrok", " chores ")
+			},
+			wantTags:  []string{"chores", "go", "work"},
+			wantItemA: []string{"chores", "work"},
+			wantItemB: []string{"chores", "go"},
+		},
+		{
+			name: "rename onto existing tag is rejected",
+			apply: func(ctx context.Context, svc *service.Service) error {
+				return svc.RenameTag(ctx, "wrok", "work")
+			},
+			wantErr:   "already exists",
+			wantTags:  []string{"go", "work", "wrok"},
+			wantItemA: []string{"work", "wrok"},
+			wantItemB: []string{"go", "wrok"},
+		},
+		{
+			name: "rename to empty name is rejected",
+			apply: func(ctx context.Context, svc *service.Service) error {
+				return svc.RenameTag(ctx, "wrok", "  ")
+			},
+			wantErr:   "tag must not be empty",
+			wantTags:  []string{"go", "work", "wrok"},
+			wantItemA: []string{"work", "wrok"},
+			wantItemB: []string{"go", "wrok"},
+		},
+		{
+			name: "rename of a missing tag is rejected",
+			apply: func(ctx context.Context, svc *service.Service) error {
+				return svc.RenameTag(ctx, "missing", "chores")
+			},
+			wantErr:   `tag "missing" not found`,
+			wantTags:  []string{"go", "work", "wrok"},
+			wantItemA: []string{"work", "wrok"},
+			wantItemB: []string{"go", "wrok"},
+		},
+		{
+			name: "merge normalizes the sources",
+			apply: func(ctx context.Context, svc *service.Service) error {
+				return svc.MergeTags(ctx, "work", "Wrok/ ")
+			},
+			wantTags:  []string{"go", "work"},
+			wantItemA: []string{"work"},
+			wantItemB: []string{"go", "work"},
+		},
+		{
+			name: "merge of a missing source is rejected",
+			apply: func(ctx context.Context, svc *service.Service) error {
+				return svc.MergeTags(ctx, "go", "wrok", "missing")
+			},
+			wantErr:   `tag "missing" not found`,
+			wantTags:  []string{"go", "work", "wrok"},
+			wantItemA: []string{"work", "wrok"},
+			wantItemB: []string{"go", "wrok"},
+		},
+		{
+			name: "merge skips items that already have the target",
+			apply: func(ctx context.Context, svc *service.Service) error {
+				return svc.MergeTags(ctx, "work", "wrok")
+			},
+			wantTags:  []string{"go", "work"},
+			wantItemA: []string{"work"},
+			wantItemB: []string{"go", "work"},
+		},
+		{
+			name: "merge several sources",
+			apply: func(ctx context.Context, svc *service.Service) error {
+				return svc.MergeTags(ctx, "go", "wrok", "work", "go")
+			},
+			wantTags:  []string{"go"},
+			wantItemA: []string{"go"},
+			wantItemB: []string{"go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Tags")
+			itemA := mustCreateItem(ctx, t, svc, board, "a", "first")
+			itemB := mustCreateItem(ctx, t, svc, board, "b", "second")
+			itemA.Tags = []string{"work", "wrok"}
+			_ = mustUpdateItem(ctx, t, svc, itemA)
+			itemB.Tags = []string{"go", "wrok"}
+			_ = mustUpdateItem(ctx, t, svc, itemB)
+
+			err := tt.apply(ctx, svc)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			tags, err := svc.ListTags(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTags, tags)
+
+			items := mustListItemsByBoard(ctx, t, svc, board)
+			require.Len(t, *items, 2)
+			assert.ElementsMatch(t, tt.wantItemA, (*items)[0].Tags)
+			assert.ElementsMatch(t, tt.wantItemB, (*items)[1].Tags)
+		})
+	}
+}
+
+func TestTagMetadata(t *testing.T) {
+	tests := []struct {
+		name    string
+		color   string
+		wantErr string
+	}{
+		{name: "hex color", color: "#7D56F4"},
+		{name: "short hex color", color: "#f80"},
+		{name: "ansi color", color: "202"},
+		{name: "no color", color: ""},
+		{name: "rejects named color", color: "red", wantErr: "invalid color"},
+		{name: "rejects out of range ansi color", color: "256", wantErr: "invalid color"},
+		{name: "rejects malformed hex color", color: "#12345", wantErr: "invalid color"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			meta, err := svc.GetTagMetadata(ctx, "work")
+			require.NoError(t, err)
+			assert.Equal(t, "work", meta.Tag)
+			assert.Empty(t, meta.Color)
+
+			meta.Color = tt.color
+			meta.Emoji = "💼"
+			meta.Description = " day job "
+			saved, err := svc.UpdateTagMetadata(ctx, meta)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.color, saved.Color)
+			assert.Equal(t, "day job", saved.Description)
+
+			all, err := svc.ListTagMetadata(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, "💼", all["work"].Emoji)
+		})
+	}
+
+	t.Run("clearing every field removes metadata", func(t *testing.T) {
+		svc, cleanup := testutil.NewTestService(t)
+		defer cleanup()
+
+		ctx := testutil.MustContext()
+		_, err := svc.UpdateTagMetadata(ctx, &service.TagMetadata{TagMetadata: repository.TagMetadata{
+			Tag:   "work",
+			Color: "#fff",
+		}})
+		require.NoError(t, err)
+		_, err = svc.UpdateTagMetadata(ctx, &service.TagMetadata{TagMetadata: repository.TagMetadata{Tag: "work"}})
+		require.NoError(t, err)
+
+		all, err := svc.ListTagMetadata(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+	})
+
+	t.Run("metadata follows rename, merge and delete", func(t *testing.T) {
+		svc, cleanup := testutil.NewTestService(t)
+		defer cleanup()
+
+		ctx := testutil.MustContext()
+		board := mustCreateBoard(ctx, t, svc, "Tags")
+		item := mustCreateItem(ctx, t, svc, board, "a", "first")
+		item.Tags = []string{"wrok", "home", "house"}
+		_ = mustUpdateItem(ctx, t, svc, item)
+		for _, tag := range []string{"wrok", "home", "house"} {
+			_, err := svc.UpdateTagMetadata(ctx, &service.TagMetadata{TagMetadata: repository.TagMetadata{
+				Tag:         tag,
+				Description: tag + " things",
+			}})
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, svc.RenameTag(ctx, "wrok", "work"))
+		require.NoError(t, svc.MergeTags(ctx, "home", "house"))
+		all, err := svc.ListTagMetadata(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, "wrok things", all["work"].Description)
+		assert.Equal(t, "home things", all["home"].Description)
+
+		require.NoError(t, svc.DeleteTag(ctx, "work"))
+		all, err = svc.ListTagMetadata(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, all, "work")
+	})
+}
+
+func TestHierarchicalTags(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Tags")
+	itemA := mustCreateItem(ctx, t, svc, board, "a", "first")
+	itemA.Tags = []string{"work/infra", "work/frontend"}
+	_ = mustUpdateItem(ctx, t, svc, itemA)
+	itemB := mustCreateItem(ctx, t, svc, board, "b", "second")
+	itemB.Tags = []string{"work"}
+	_ = mustUpdateItem(ctx, t, svc, itemB)
+	itemC := mustCreateItem(ctx, t, svc, board, "c", "third")
+	itemC.Tags = []string{"workshop"}
+	_ = mustUpdateItem(ctx, t, svc, itemC)
+
+	tests := []struct {
+		tag       string
+		wantCount int
+		wantItems []string
+	}{
+		{tag: "work", wantCount: 2, wantItems: []string{"a", "b"}},
+		{tag: "work/infra", wantCount: 1, wantItems: []string{"a"}},
+		{tag: "workshop", wantCount: 1, wantItems: []string{"c"}},
+		{tag: "work/infra/k8s", wantCount: 0},
+	}
+	for _, tt := range tests {
+		t.Run("subtree of "+tt.tag, func(t *testing.T) {
+			assert.Equal(t, tt.wantCount, mustCountItemsByTag(ctx, t, svc, tt.tag))
+			items, err := svc.ListItemsByTag(ctx, tt.tag)
+			require.NoError(t, err)
+			var titles []string
+			for _, item := range *items {
+				titles = append(titles, item.Title)
+			}
+			assert.Equal(t, tt.wantItems, titles)
+		})
+	}
+
+	t.Run("items keep all their tags once", func(t *testing.T) {
+		items, err := svc.ListItemsByTag(ctx, "work")
+		require.NoError(t, err)
+		require.NotEmpty(t, *items)
+		assert.ElementsMatch(t, []string{"work/infra", "work/frontend"}, (*items)[0].Tags)
+	})
+
+	t.Run("renaming a parent renames its subtree", func(t *testing.T) {
+		_, err := svc.UpdateTagMetadata(ctx, &service.TagMetadata{TagMetadata: repository.TagMetadata{
+			Tag:   "work/infra",
+			Emoji: "🛠",
+		}})
+		require.NoError(t, err)
+
+		err = svc.RenameTag(ctx, "work", "work/old")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "below itself")
+
+		require.NoError(t, svc.RenameTag(ctx, "work", "job"))
+		tags, err := svc.ListTags(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"job", "job/frontend", "job/infra", "workshop"}, tags)
+
+		meta, err := svc.ListTagMetadata(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "🛠", meta["job/infra"].Emoji)
+	})
+
+	t.Run("renaming onto an existing subtree is rejected", func(t *testing.T) {
+		item := mustCreateItem(ctx, t, svc, board, "d", "fourth")
+		item.Tags = []string{"team/infra"}
+		_ = mustUpdateItem(ctx, t, svc, item)
+
+		err := svc.RenameTag(ctx, "job", "team")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"team/infra" already exists`)
+	})
+}
+
+func TestDeleteParentTag(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Tags")
+	item := mustCreateItem(ctx, t, svc, board, "a", "")
+	item.Tags = []string{"work/infra", "work/frontend/css", "workshop"}
+	_ = mustUpdateItem(ctx, t, svc, item)
+	_, err := svc.SetBoardDefaultTags(ctx, board, []string{"work/infra", "home"})
+	require.NoError(t, err)
+	for _, tag := range []string{"work", "work/infra"} {
+		_, err = svc.UpdateTagMetadata(ctx, &service.TagMetadata{TagMetadata: repository.TagMetadata{
+			Tag:   tag,
+			Emoji: "🛠",
+		}})
+		require.NoError(t, err)
+	}
+
+	// No item is tagged "work" itself.
+	require.NoError(t, svc.DeleteTag(ctx, "work"))
+
+	tags, err := svc.ListTags(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"workshop"}, tags)
+	boards, err := svc.ListBoards(ctx)
+	require.NoError(t, err)
+	require.Len(t, *boards, 1)
+	assert.Equal(t, []string{"home"}, (*boards)[0].DefaultTags)
+	meta, err := svc.ListTagMetadata(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, meta)
+}
+
+func TestTagSubtreeHeldByBoardDefaults(t *testing.T) {
+	tests := []struct {
+		name         string
+		apply        func(context.Context, *service.Service) error
+		wantDefaults []string
+		wantMeta     []string
+	}{
+		{
+			name:         "delete",
+			apply:        func(ctx context.Context, svc *service.Service) error { return svc.DeleteTag(ctx, "work") },
+			wantDefaults: []string{"home"},
+		},
+		{
+			name:         "rename",
+			apply:        func(ctx context.Context, svc *service.Service) error { return svc.RenameTag(ctx, "work", "job") },
+			wantDefaults: []string{"home", "job/ops"},
+			wantMeta:     []string{"job/docs"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Tags")
+			item := mustCreateItem(ctx, t, svc, board, "a", "")
+			item.Tags = []string{"work/infra"}
+			_ = mustUpdateItem(ctx, t, svc, item)
+			// Only the board holds "work/ops" and only metadata holds "work/docs".
+			_, err := svc.SetBoardDefaultTags(ctx, board, []string{"work/ops", "home"})
+			require.NoError(t, err)
+			_, err = svc.UpdateTagMetadata(ctx, &service.TagMetadata{TagMetadata: repository.TagMetadata{
+				Tag:   "work/docs",
+				Emoji: "📄",
+			}})
+			require.NoError(t, err)
+
+			require.NoError(t, tt.apply(ctx, svc))
+
+			boards, err := svc.ListBoards(ctx)
+			require.NoError(t, err)
+			require.Len(t, *boards, 1)
+			assert.ElementsMatch(t, tt.wantDefaults, (*boards)[0].DefaultTags)
+			meta, err := svc.ListTagMetadata(ctx)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.wantMeta, slices.Collect(maps.Keys(meta)))
+		})
+	}
+}
+
+func TestTagParent(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{tag: "work", want: ""},
+		{tag: "work/infra", want: "work"},
+		{tag: "work/infra/k8s", want: "work/infra"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			assert.Equal(t, tt.want, service.TagParent(tt.tag))
+		})
+	}
+}
+
+func TestListTagUsage(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Tags")
+	for _, tags := range [][]string{{"work", "go"}, {"work"}, {"home"}} {
+		item := mustCreateItem(ctx, t, svc, board, "task", "")
+		item.Tags = tags
+		_ = mustUpdateItem(ctx, t, svc, item)
+	}
+	trashed := mustCreateItem(ctx, t, svc, board, "trashed", "")
+	trashed.Tags = []string{"home", "old"}
+	_ = mustUpdateItem(ctx, t, svc, trashed)
+	mustDeleteItem(ctx, t, svc, trashed)
+
+	usage, err := svc.ListTagUsage(ctx)
+	require.NoError(t, err)
+	got := make(map[string]int64, len(usage))
+	order := make([]string, len(usage))
+	for i, u := range usage {
+		got[u.Tag] = u.Uses
+		order[i] = u.Tag
+	}
+	assert.Equal(t, map[string]int64{"work": 2, "go": 1, "home": 1}, got)
+	assert.Equal(t, []string{"work", "go", "home"}, order)
+}
+
+func TestListTagSummaries(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Tags")
+	for _, tt := range []struct {
+		tags      []string
+		completed bool
+	}{
+		{tags: []string{"work/infra"}},
+		{tags: []string{"work/ui"}, completed: true},
+		{tags: []string{"home"}},
+	} {
+		item := mustCreateItem(ctx, t, svc, board, "task", "")
+		item.Tags = tt.tags
+		item.Completed = tt.completed
+		_ = mustUpdateItem(ctx, t, svc, item)
+	}
+	trashed := mustCreateItem(ctx, t, svc, board, "trashed", "")
+	trashed.Tags = []string{"home", "old"}
+	_ = mustUpdateItem(ctx, t, svc, trashed)
+	mustDeleteItem(ctx, t, svc, trashed)
+
+	summaries, err := svc.ListTagSummaries(ctx)
+	require.NoError(t, err)
+
+	type counts struct{ open, done int64 }
+	got := make(map[string]counts, len(summaries))
+	order := make([]string, len(summaries))
+	for i, s := range summaries {
+		got[s.Tag] = counts{s.Open, s.Done}
+		order[i] = s.Tag
+		assert.NotNil(t, s.LastActivityAt, s.Tag)
+	}
+	assert.Equal(t, map[string]counts{
+		"home":       {1, 0},
+		"work":       {1, 1},
+		"work/infra": {1, 0},
+		"work/ui":    {0, 1},
+	}, got)
+	assert.Equal(t, []string{"home", "work", "work/infra", "work/ui"}, order)
+}
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    string
+		wantErr string
+	}{
+		{name: "case is folded", tag: "Work", want: "work"},
+		{name: "whitespace is trimmed", tag: "  work ", want: "work"},
+		{name: "inner whitespace becomes a dash", tag: "Day  Job", want: "day-job"},
+		{name: "levels are trimmed and empty ones dropped", tag: "/Work / Infra//", want: "work/infra"},
+		{name: "unicode letters are allowed", tag: "Café_2.0", want: "café_2.0"},
+		{name: "empty tag", tag: " / ", wantErr: "tag must not be empty"},
+		{
+			name:    "disallowed character",
+			tag:     "work!",
+			wantErr: `invalid tag "work!": '!' is not allowed, use letters, digits, "-", "_" or "."`,
+		},
+		{
+			name:    "too long",
+			tag:     strings.Repeat("a", service.MaxTagLength+1),
+			wantErr: "65 characters, at most 64 are allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := service.NormalizeTag(tt.tag)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUpdateItemNormalizesTags(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Tags")
+	item := mustCreateItem(ctx, t, svc, board, "task", "")
+
+	item.Tags = []string{"Work", "work ", "Day Job"}
+	item = mustUpdateItem(ctx, t, svc, item)
+	assert.Equal(t, []string{"work", "day-job"}, item.Tags)
+
+	tags, err := svc.ListTags(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"day-job", "work"}, tags)
+
+	item.Tags = []string{"work", "urgent!"}
+	_, err = svc.UpdateItem(ctx, item)
+	var tagErr *service.TagError
+	require.ErrorAs(t, err, &tagErr)
+	assert.Equal(t, "urgent!", tagErr.Tag)
+
+	require.NoError(t, svc.RenameTag(ctx, "day-job", "Side Project"))
+	tags, err = svc.ListTags(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"side-project", "work"}, tags)
+}
+
+func TestBoardDefaultTags(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Work")
+	other := mustCreateBoard(ctx, t, svc, "Home")
+
+	board, err := svc.SetBoardDefaultTags(ctx, board, []string{"Work", "urgent", "work"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"urgent", "work"}, board.DefaultTags)
+
+	_, err = svc.SetBoardDefaultTags(ctx, board, []string{"bad tag!"})
+	var tagErr *service.TagError
+	require.ErrorAs(t, err, &tagErr)
+
+	item := mustCreateItem(ctx, t, svc, board, "task", "")
+	assert.Equal(t, []string{"urgent", "work"}, item.Tags)
+	assert.Empty(t, mustCreateItem(ctx, t, svc, other, "chore", "").Tags)
+
+	items := mustListItemsByBoard(ctx, t, svc, board)
+	require.Len(t, *items, 1)
+	assert.ElementsMatch(t, []string{"urgent", "work"}, (*items)[0].Tags)
+
+	// Renaming, merging and deleting tags carry over to board defaults.
+	require.NoError(t, svc.RenameTag(ctx, "work", "job"))
+	require.NoError(t, svc.MergeTags(ctx, "job", "urgent"))
+	boards := mustListBoards(ctx, t, svc)
+	require.Len(t, *boards, 2)
+	assert.Equal(t, []string{"job"}, (*boards)[0].DefaultTags)
+	assert.Empty(t, (*boards)[1].DefaultTags)
+
+	require.NoError(t, svc.DeleteTag(ctx, "job"))
+	boards = mustListBoards(ctx, t, svc)
+	assert.Empty(t, (*boards)[0].DefaultTags)
+}
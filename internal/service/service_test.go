@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -133,6 +134,107 @@ func TestTagCounts(t *testing.T) {
 	}
 }
 
+func TestGetItem(t *testing.T) {
+	tests := []struct {
+		name    string
+		trash   func(ctx context.Context, svc *service.Service, board *service.Board, item *service.Item) error
+		wantErr bool
+	}{
+		{name: "returns item with tags"},
+		{
+			name: "item in trash is not found",
+			trash: func(ctx context.Context, svc *service.Service, _ *service.Board, item *service.Item) error {
+				return svc.DeleteItem(ctx, item)
+			},
+			wantErr: true,
+		},
+		{
+			name: "item on board in trash is not found",
+			trash: func(ctx context.Context, svc *service.Service, board *service.Board, _ *service.Item) error {
+				return svc.DeleteBoard(ctx, board)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Inbox")
+			item := mustCreateItem(ctx, t, svc, board, "task", "desc")
+			item.Tags = []string{"work", "go"}
+			item = mustUpdateItem(ctx, t, svc, item)
+
+			if tt.trash != nil {
+				require.NoError(t, tt.trash(ctx, svc, board, item))
+			}
+
+			got, err := svc.GetItem(ctx, item.ID)
+			if tt.wantErr {
+				require.ErrorIs(t, err, sql.ErrNoRows)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "task", got.Title)
+			assert.ElementsMatch(t, []string{"work", "go"}, got.Tags)
+		})
+	}
+}
+
+func TestCreateItemFrom(t *testing.T) {
+	tests := []struct {
+		name     string
+		subtasks []string
+		wantErr  string
+	}{
+		{name: "creates item with its checklist", subtasks: []string{"first", "second"}},
+		{
+			name:     "invalid subtask rolls the item back",
+			subtasks: []string{"first", " "},
+			wantErr:  "subtask title must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board := mustCreateBoard(ctx, t, svc, "Inbox")
+			item := &service.Item{Tags: []string{"work"}}
+			item.Title, item.Completed, item.Priority = "task", true, int64(service.PriorityHigh)
+			for i, title := range tt.subtasks {
+				subtask := service.Subtask{}
+				subtask.Title, subtask.Completed = title, i == 0
+				item.Subtasks = append(item.Subtasks, subtask)
+			}
+
+			created, err := svc.CreateItemFrom(ctx, board, item)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, *mustListItemsByBoard(ctx, t, svc, board))
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, created.Completed)
+			assert.Equal(t, int64(service.PriorityHigh), created.Priority)
+			assert.Equal(t, []string{"work"}, created.Tags)
+			assert.Equal(t, int64(2), created.SubtasksTotal)
+			assert.Equal(t, int64(1), created.SubtasksDone)
+
+			subtasks, err := svc.ListSubtasks(ctx, created)
+			require.NoError(t, err)
+			require.Len(t, *subtasks, 2)
+			assert.True(t, (*subtasks)[0].Completed)
+			assert.False(t, (*subtasks)[1].Completed)
+		})
+	}
+}
+
 func mustCreateBoard(ctx context.Context, t *testing.T, svc *service.Service, name string) *service.Board {
 	t.Helper()
 	board, err := svc.CreateBoard(ctx, name)
@@ -167,7 +269,7 @@ func mustCreateItem(
 	title, desc string,
 ) *service.Item {
 	t.Helper()
-	item, err := svc.CreateItem(ctx, board, title, desc)
+	item, err := svc.CreateItem(ctx, board, title, desc, nil)
 	require.NoError(t, err)
 	return item
 }
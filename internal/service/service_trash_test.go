@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/testutil"
+)
+
+func TestTrash(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := mustCreateBoard(ctx, t, svc, "Inbox")
+	item := mustCreateItem(ctx, t, svc, board, "task", "")
+	item.Tags = []string{"work"}
+	item = mustUpdateItem(ctx, t, svc, item)
+	other := mustCreateItem(ctx, t, svc, board, "other", "")
+
+	mustDeleteItem(ctx, t, svc, item)
+
+	items := *mustListItemsByBoard(ctx, t, svc, board)
+	require.Len(t, items, 1)
+	assert.Equal(t, other.ID, items[0].ID)
+	tags, err := svc.ListTags(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	deleted, err := svc.ListDeletedItems(ctx)
+	require.NoError(t, err)
+	require.Len(t, *deleted, 1)
+	assert.Equal(t, "Inbox", (*deleted)[0].BoardName)
+	assert.Equal(t, []string{"work"}, (*deleted)[0].Tags)
+	assert.NotNil(t, (*deleted)[0].DeletedAt)
+
+	// Restoring an item of a deleted board brings the board back as well.
+	mustDeleteBoard(ctx, t, svc, board)
+	assert.Empty(t, *mustListBoards(ctx, t, svc))
+	require.NoError(t, svc.RestoreItem(ctx, &(*deleted)[0].Item))
+	assertBoardNames(t, mustListBoards(ctx, t, svc), []string{"Inbox"})
+	items = *mustListItemsByBoard(ctx, t, svc, board)
+	require.Len(t, items, 2)
+	assert.Equal(t, item.ID, items[1].ID, "restored item goes to the end of its board")
+
+	// Items still in the trash are kept until purged or past retention.
+	mustDeleteItem(ctx, t, svc, other)
+	purged, err := svc.PurgeTrash(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+	require.NoError(t, svc.PurgeItem(ctx, other))
+	deleted, err = svc.ListDeletedItems(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, *deleted)
+
+	mustDeleteBoard(ctx, t, svc, board)
+	boards, err := svc.ListDeletedBoards(ctx)
+	require.NoError(t, err)
+	require.Len(t, *boards, 1)
+	require.NoError(t, svc.RestoreBoard(ctx, &(*boards)[0]))
+	assertBoardNames(t, mustListBoards(ctx, t, svc), []string{"Inbox"})
+}
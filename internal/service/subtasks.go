@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/repository"
+)
+
+// ListSubtasks returns the checklist of item in display order.
+func (s *Service) ListSubtasks(ctx context.Context, item *Item) (*[]Subtask, error) {
+	data, err := s.Repo.ListSubtasksByItemID(ctx, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	subtasks := make([]Subtask, len(data))
+	for i, v := range data {
+		subtasks[i] = Subtask{v}
+	}
+	return &subtasks, nil
+}
+
+// AddSubtask appends a new subtask to the end of the checklist of item.
+func (s *Service) AddSubtask(ctx context.Context, item *Item, title string) (*Subtask, error) {
+	return createSubtask(ctx, s.Repo, item.ID, title)
+}
+
+// createSubtask adds a subtask titled title to the end of the checklist of the
+// item with itemID using q.
+func createSubtask(ctx context.Context, q *repository.Queries, itemID int64, title string) (*Subtask, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, errors.New("subtask title must not be empty")
+	}
+	data, err := q.CreateSubtask(ctx, repository.CreateSubtaskParams{
+		ItemID: itemID,
+		Title:  title,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Subtask{data}, nil
+}
+
+// UpdateSubtask saves the title and completion state of subtask.
+func (s *Service) UpdateSubtask(ctx context.Context, subtask *Subtask) (*Subtask, error) {
+	title := strings.TrimSpace(subtask.Title)
+	if title == "" {
+		return nil, errors.New("subtask title must not be empty")
+	}
+	data, err := s.Repo.UpdateSubtaskByID(ctx, repository.UpdateSubtaskByIDParams{
+		Title:     title,
+		Completed: subtask.Completed,
+		ID:        subtask.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Subtask{data}, nil
+}
+
+// ToggleSubtask flips the completion state of subtask.
+func (s *Service) ToggleSubtask(ctx context.Context, subtask *Subtask) (*Subtask, error) {
+	toggled := *subtask
+	toggled.Completed = !toggled.Completed
+	return s.UpdateSubtask(ctx, &toggled)
+}
+
+// MoveSubtask moves subtask to index within its checklist, shifting the others.
+// Out of range indexes are clamped to the start or end of the checklist.
+func (s *Service) MoveSubtask(ctx context.Context, subtask *Subtask, index int) (*[]Subtask, error) {
+	var data []repository.Subtask
+	err := s.withTx(ctx, func(q *repository.Queries) error {
+		var err error
+		if data, err = q.ListSubtasksByItemID(ctx, subtask.ItemID); err != nil {
+			return err
+		}
+		entries := make([]positioned, len(data))
+		for i, v := range data {
+			entries[i] = positioned{ID: v.ID, Position: v.Position}
+		}
+		err = reorder(entries, subtask.ID, at(index), func(id, position int64) error {
+			return q.SetSubtaskPositionByID(ctx, repository.SetSubtaskPositionByIDParams{
+				Position: position,
+				ID:       id,
+			})
+		})
+		if errors.Is(err, errEntryNotFound) {
+			return errors.New("subtask not found")
+		}
+		if err != nil {
+			return err
+		}
+		data, err = q.ListSubtasksByItemID(ctx, subtask.ItemID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	subtasks := make([]Subtask, len(data))
+	for i, v := range data {
+		subtasks[i] = Subtask{v}
+	}
+	return &subtasks, nil
+}
+
+// DeleteSubtask removes subtask from its checklist.
+func (s *Service) DeleteSubtask(ctx context.Context, subtask *Subtask) error {
+	return s.Repo.DeleteSubtaskByID(ctx, subtask.ID)
+}
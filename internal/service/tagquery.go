@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/rhajizada/donezo/internal/repository"
+)
+
+// TagQuery is a parsed boolean expression over tags such as
+//
+//	work AND urgent AND NOT later
+//	(home OR errands) AND NOT done
+//
+// NOT binds tighter than AND, which binds tighter than OR. The keywords are
+// case-insensitive and every other word is a tag, which like ListItemsByTag
+// also matches the tags below it.
+type TagQuery struct {
+	root tagQueryNode
+}
+
+// tagQueryNode is a node of the expression tree: a tag when op is empty,
+// otherwise NOT with one operand or AND/OR with two.
+type tagQueryNode struct {
+	op       string
+	tag      string
+	operands []tagQueryNode
+}
+
+type tagQueryToken struct {
+	text string
+	pos  int
+}
+
+const (
+	tagQueryAnd = "AND"
+	tagQueryOr  = "OR"
+	tagQueryNot = "NOT"
+)
+
+// ParseTagQuery parses a boolean tag expression. Errors point at the
+// offending position, counted in characters from 1.
+func ParseTagQuery(query string) (TagQuery, error) {
+	p := tagQueryParser{tokens: tokenizeTagQuery(query), end: len([]rune(query)) + 1}
+	if len(p.tokens) == 0 {
+		return TagQuery{}, errors.New("empty query: expected a tag")
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return TagQuery{}, err
+	}
+	if tok, ok := p.peek(); ok {
+		if tok.text == ")" {
+			return TagQuery{}, fmt.Errorf("unexpected \")\" at position %d: no matching \"(\"", tok.pos)
+		}
+		return TagQuery{}, fmt.Errorf(
+			"unexpected %q at position %d: expected AND or OR", tok.text, tok.pos,
+		)
+	}
+	return TagQuery{root: root}, nil
+}
+
+// String returns the query in canonical form, with upper-case keywords and
+// parentheses only where they are needed.
+func (q TagQuery) String() string {
+	return q.root.format(0)
+}
+
+func tagQueryPrecedence(op string) int {
+	switch op {
+	case tagQueryOr:
+		return 1
+	case tagQueryAnd:
+		return 2
+	case tagQueryNot:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func (n tagQueryNode) format(parent int) string {
+	var s string
+	switch n.op {
+	case "":
+		return n.tag
+	case tagQueryNot:
+		s = "NOT " + n.operands[0].format(tagQueryPrecedence(n.op))
+	default:
+		prec := tagQueryPrecedence(n.op)
+		s = n.operands[0].format(prec) + " " + n.op + " " + n.operands[1].format(prec)
+	}
+	if tagQueryPrecedence(n.op) < parent {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+// sql compiles the node into a condition on items aliased as i.
+func (n tagQueryNode) sql(args []any) (string, []any) {
+	switch n.op {
+	case "":
+		args = append(args, n.tag, n.tag, n.tag)
+		return "EXISTS (SELECT 1 FROM tags m WHERE m.item_id = i.id " +
+			"AND (m.tag = ? OR substr(m.tag, 1, length(?) + 1) = ? || '/'))", args
+	case tagQueryNot:
+		cond, args := n.operands[0].sql(args)
+		return "NOT " + cond, args
+	default:
+		left, args := n.operands[0].sql(args)
+		right, args := n.operands[1].sql(args)
+		return "(" + left + " " + n.op + " " + right + ")", args
+	}
+}
+
+func tokenizeTagQuery(query string) []tagQueryToken {
+	var tokens []tagQueryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, tagQueryToken{text: string(r), pos: i + 1})
+			i++
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '(' && runes[i] != ')' {
+				i++
+			}
+			tokens = append(tokens, tagQueryToken{text: string(runes[start:i]), pos: start + 1})
+		}
+	}
+	return tokens
+}
+
+type tagQueryParser struct {
+	tokens []tagQueryToken
+	next   int
+	end    int
+}
+
+func (p *tagQueryParser) peek() (tagQueryToken, bool) {
+	if p.next >= len(p.tokens) {
+		return tagQueryToken{}, false
+	}
+	return p.tokens[p.next], true
+}
+
+// accept consumes the next token if it is the keyword op.
+func (p *tagQueryParser) accept(op string) bool {
+	tok, ok := p.peek()
+	if ok && strings.EqualFold(tok.text, op) {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *tagQueryParser) parseOr() (tagQueryNode, error) {
+	return p.parseBinary(tagQueryOr, p.parseAnd)
+}
+
+func (p *tagQueryParser) parseAnd() (tagQueryNode, error) {
+	return p.parseBinary(tagQueryAnd, p.parseNot)
+}
+
+func (p *tagQueryParser) parseBinary(op string, operand func() (tagQueryNode, error)) (tagQueryNode, error) {
+	left, err := operand()
+	if err != nil {
+		return tagQueryNode{}, err
+	}
+	for p.accept(op) {
+		right, err := operand()
+		if err != nil {
+			return tagQueryNode{}, err
+		}
+		left = tagQueryNode{op: op, operands: []tagQueryNode{left, right}}
+	}
+	return left, nil
+}
+
+func (p *tagQueryParser) parseNot() (tagQueryNode, error) {
+	if p.accept(tagQueryNot) {
+		operand, err := p.parseNot()
+		if err != nil {
+			return tagQueryNode{}, err
+		}
+		return tagQueryNode{op: tagQueryNot, operands: []tagQueryNode{operand}}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *tagQueryParser) parsePrimary() (tagQueryNode, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return tagQueryNode{}, fmt.Errorf("unexpected end of query at position %d: expected a tag", p.end)
+	}
+	p.next++
+	switch {
+	case tok.text == "(":
+		node, err := p.parseOr()
+		if err != nil {
+			return tagQueryNode{}, err
+		}
+		if closing, ok := p.peek(); !ok || closing.text != ")" {
+			return tagQueryNode{}, fmt.Errorf("missing \")\" for \"(\" at position %d", tok.pos)
+		}
+		p.next++
+		return node, nil
+	case tok.text == ")":
+		return tagQueryNode{}, fmt.Errorf("unexpected \")\" at position %d: expected a tag", tok.pos)
+	case strings.EqualFold(tok.text, tagQueryAnd), strings.EqualFold(tok.text, tagQueryOr):
+		return tagQueryNode{}, fmt.Errorf(
+			"unexpected %s at position %d: expected a tag", strings.ToUpper(tok.text), tok.pos,
+		)
+	default:
+		tag, err := NormalizeTag(tok.text)
+		if err != nil {
+			return tagQueryNode{}, fmt.Errorf("%w at position %d", err, tok.pos)
+		}
+		return tagQueryNode{tag: tag}, nil
+	}
+}
+
+// listItemsByTagQuery selects the same columns as the ListItemsByTag query;
+// the condition is filled in from the compiled TagQuery.
+const listItemsByTagQuery = `SELECT
+    i.id,
+    i.board_id,
+    i.title,
+    i.description,
+    i.completed,
+    i.created_at,
+    i.last_updated_at,
+    i.due_at,
+    i.priority,
+    i.recurrence,
+    i.position,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id) AS subtasks_total,
+    (SELECT COUNT(*) FROM subtasks s WHERE s.item_id = i.id AND s.completed) AS subtasks_done,
+    (
+        SELECT COUNT(*)
+        FROM item_dependencies d
+        JOIN items blocker ON blocker.id = d.blocker_id
+        WHERE d.item_id = i.id AND NOT blocker.completed AND blocker.deleted_at IS NULL
+    ) AS blocked_by,
+    (SELECT COUNT(*) FROM item_dependencies d WHERE d.blocker_id = i.id) AS dependents,
+    COALESCE((SELECT json_group_array(t.tag) FROM tags t WHERE t.item_id = i.id), '[]') AS tags
+FROM items i
+JOIN boards b ON b.id = i.board_id
+WHERE %s AND i.deleted_at IS NULL AND b.deleted_at IS NULL
+ORDER BY i.created_at`
+
+// ListItemsByTagQuery returns the items matching a boolean tag expression,
+// see ParseTagQuery.
+func (s *Service) ListItemsByTagQuery(ctx context.Context, query string) (*[]Item, error) {
+	parsed, err := ParseTagQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	cond, args := parsed.root.sql(nil)
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(listItemsByTagQuery, cond), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var data []repository.ListItemsByTagRow
+	for rows.Next() {
+		var i repository.ListItemsByTagRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BoardID,
+			&i.Title,
+			&i.Description,
+			&i.Completed,
+			&i.CreatedAt,
+			&i.LastUpdatedAt,
+			&i.DueAt,
+			&i.Priority,
+			&i.Recurrence,
+			&i.Position,
+			&i.SubtasksTotal,
+			&i.SubtasksDone,
+			&i.BlockedBy,
+			&i.Dependents,
+			&i.Tags,
+		); err != nil {
+			return nil, err
+		}
+		data = append(data, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	items := tagRowsToItems(data)
+	return &items, nil
+}
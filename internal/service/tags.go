@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/rhajizada/donezo/internal/color"
+	"github.com/rhajizada/donezo/internal/repository"
+)
+
+// TagPathSeparator separates the levels of hierarchical tags such as
+// "work/infra".
+const TagPathSeparator = "/"
+
+// MaxTagLength is the maximum length of a tag in characters, including the
+// separators of hierarchical tags.
+const MaxTagLength = 64
+
+// TagError reports a tag that cannot be brought into canonical form.
+type TagError struct {
+	Tag    string
+	Reason string
+}
+
+func (e *TagError) Error() string {
+	return fmt.Sprintf("invalid tag \"%s\": %s", e.Tag, e.Reason)
+}
+
+// NormalizeTag returns the canonical form of tag: lower case, with each
+// level trimmed, inner whitespace turned into "-" and empty levels dropped,
+// so "Work / Day Job" becomes "work/day-job". Levels may only contain
+// letters, digits, "-", "_" and ".".
+func NormalizeTag(tag string) (string, error) {
+	var levels []string
+	for level := range strings.SplitSeq(strings.ToLower(tag), TagPathSeparator) {
+		level = strings.Join(strings.Fields(level), "-")
+		if level == "" {
+			continue
+		}
+		for _, r := range level {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_.", r) {
+				return "", &TagError{
+					Tag:    tag,
+					Reason: fmt.Sprintf("%q is not allowed, use letters, digits, \"-\", \"_\" or \".\"", r),
+				}
+			}
+		}
+		levels = append(levels, level)
+	}
+	if len(levels) == 0 {
+		return "", errors.New("tag must not be empty")
+	}
+	normalized := strings.Join(levels, TagPathSeparator)
+	if n := len([]rune(normalized)); n > MaxTagLength {
+		return "", &TagError{
+			Tag:    tag,
+			Reason: fmt.Sprintf("%d characters, at most %d are allowed", n, MaxTagLength),
+		}
+	}
+	return normalized, nil
+}
+
+// NormalizeTags brings every tag into canonical form and drops the duplicates
+// this produces, keeping the first occurrence.
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		n, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(normalized, n) {
+			normalized = append(normalized, n)
+		}
+	}
+	return normalized, nil
+}
+
+// TagParent returns the tag one level above tag, or "" for a top level tag.
+func TagParent(tag string) string {
+	idx := strings.LastIndex(tag, TagPathSeparator)
+	if idx < 0 {
+		return ""
+	}
+	return tag[:idx]
+}
+
+// parseTimestamp parses a timestamp computed by SQLite, where the column type
+// is lost and the value comes back as text. It returns nil for an empty or
+// unrecognized value.
+func parseTimestamp(value string) *time.Time {
+	for _, layout := range sqlite3.SQLiteTimestampFormats {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// RenameTag renames tag to name on every item, including items in the trash.
+// Tags below tag are moved along, so renaming "work" to "job" turns
+// "work/infra" into "job/infra". Renaming onto a tag that is already in use
+// is rejected; use MergeTags for that instead.
+func (s *Service) RenameTag(ctx context.Context, tag, name string) error {
+	tag, err := NormalizeTag(tag)
+	if err != nil {
+		return err
+	}
+	if name, err = NormalizeTag(name); err != nil {
+		return err
+	}
+	if name == tag {
+		return nil
+	}
+	if strings.HasPrefix(name, tag+TagPathSeparator) {
+		return fmt.Errorf("cannot move tag \"%s\" below itself", tag)
+	}
+	return s.withTx(ctx, func(q *repository.Queries) error {
+		var subtree []string
+		if subtree, err = q.ListTagSubtree(ctx, tag); err != nil {
+			return err
+		}
+		if len(subtree) == 0 {
+			return fmt.Errorf("tag \"%s\" not found", tag)
+		}
+		targets := make([]string, len(subtree))
+		for i, source := range subtree {
+			targets[i] = name + strings.TrimPrefix(source, tag)
+			exists, existsErr := q.TagExists(ctx, targets[i])
+			if existsErr != nil {
+				return existsErr
+			}
+			if exists != 0 {
+				return fmt.Errorf("tag \"%s\" already exists, merge into it instead", targets[i])
+			}
+		}
+		for i, source := range subtree {
+			if err = moveTag(ctx, q, source, targets[i]); err != nil {
+				return err
+			}
+		}
+		return q.RenameTagMetadata(ctx, repository.RenameTagMetadataParams{
+			Target: name,
+			Source: tag,
+		})
+	})
+}
+
+// MergeTags replaces every source tag with target in a single transaction.
+// Items that already carry target keep a single copy of it, and target keeps
+// its own metadata. Every source must be in use.
+func (s *Service) MergeTags(ctx context.Context, target string, sources ...string) error {
+	target, err := NormalizeTag(target)
+	if err != nil {
+		return err
+	}
+	if sources, err = NormalizeTags(sources); err != nil {
+		return err
+	}
+	return s.withTx(ctx, func(q *repository.Queries) error {
+		for _, source := range sources {
+			if source == target {
+				continue
+			}
+			subtree, listErr := q.ListTagSubtree(ctx, source)
+			if listErr != nil {
+				return listErr
+			}
+			if !slices.Contains(subtree, source) {
+				return fmt.Errorf("tag \"%s\" not found", source)
+			}
+			if err = moveTag(ctx, q, source, target); err != nil {
+				return err
+			}
+			if err = q.DeleteTagMetadata(ctx, source); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// moveTag retags every item tagged source with target and drops source. The
+// copy skips items that already have target, so the (item_id, tag) primary
+// key is never violated. Boards with source as a default tag get target
+// instead.
+func moveTag(ctx context.Context, q *repository.Queries, source, target string) error {
+	err := q.CopyTag(ctx, repository.CopyTagParams{
+		Target: target,
+		Source: source,
+	})
+	if err != nil {
+		return err
+	}
+	if err = q.DeleteTag(ctx, source); err != nil {
+		return err
+	}
+	return q.RenameBoardDefaultTag(ctx, repository.RenameBoardDefaultTagParams{
+		Target: target,
+		Source: source,
+	})
+}
+
+// GetTagMetadata returns the metadata of tag. Tags without metadata get an
+// empty entry.
+func (s *Service) GetTagMetadata(ctx context.Context, tag string) (*TagMetadata, error) {
+	data, err := s.Repo.GetTagMetadata(ctx, tag)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &TagMetadata{repository.TagMetadata{Tag: tag}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &TagMetadata{data}, nil
+}
+
+// ListTagMetadata returns the metadata of every tag that has any, keyed by tag.
+func (s *Service) ListTagMetadata(ctx context.Context) (map[string]TagMetadata, error) {
+	data, err := s.Repo.ListTagMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	meta := make(map[string]TagMetadata, len(data))
+	for _, m := range data {
+		meta[m.Tag] = TagMetadata{m}
+	}
+	return meta, nil
+}
+
+// UpdateTagMetadata saves the color, emoji and description of meta.Tag.
+// Colors are hex values such as "#7D56F4" or ANSI color numbers from 0 to
+// 255. Clearing every field removes the metadata.
+func (s *Service) UpdateTagMetadata(ctx context.Context, meta *TagMetadata) (*TagMetadata, error) {
+	tag, err := NormalizeTag(meta.Tag)
+	if err != nil {
+		return nil, err
+	}
+	params := repository.UpsertTagMetadataParams{
+		Tag:         tag,
+		Color:       strings.TrimSpace(meta.Color),
+		Emoji:       strings.TrimSpace(meta.Emoji),
+		Description: strings.TrimSpace(meta.Description),
+	}
+	if err := validateTagColor(params.Color); err != nil {
+		return nil, err
+	}
+	if params.Color == "" && params.Emoji == "" && params.Description == "" {
+		if err := s.Repo.DeleteTagMetadata(ctx, params.Tag); err != nil {
+			return nil, err
+		}
+		return &TagMetadata{repository.TagMetadata{Tag: params.Tag}}, nil
+	}
+	data, err := s.Repo.UpsertTagMetadata(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &TagMetadata{data}, nil
+}
+
+// DeleteTagMetadata clears the metadata of tag without touching its items.
+func (s *Service) DeleteTagMetadata(ctx context.Context, tag string) error {
+	return s.Repo.DeleteTagMetadata(ctx, tag)
+}
+
+func validateTagColor(value string) error {
+	if value == "" || color.Valid(value) {
+		return nil
+	}
+	return fmt.Errorf("invalid color %q: expected #rgb, #rrggbb or 0-255", value)
+}
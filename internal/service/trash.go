@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rhajizada/donezo/internal/repository"
+)
+
+// DeletedItem is an item in the trash together with the name of its board.
+type DeletedItem struct {
+	Item
+
+	BoardName string `json:"boardName"`
+}
+
+// ListDeletedBoards returns the boards in the trash, most recently deleted first.
+func (s *Service) ListDeletedBoards(ctx context.Context) (*[]Board, error) {
+	data, err := s.Repo.ListDeletedBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	boards := make([]Board, len(data))
+	for i, b := range data {
+		boards[i] = Board{Board: b}
+	}
+	return &boards, nil
+}
+
+// ListDeletedItems returns the items in the trash, most recently deleted first.
+// Items of a deleted board are only listed if they were deleted on their own.
+func (s *Service) ListDeletedItems(ctx context.Context) (*[]DeletedItem, error) {
+	data, err := s.Repo.ListDeletedItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]DeletedItem, len(data))
+	for i, v := range data {
+		items[i] = DeletedItem{
+			Item: Item{
+				Item: repository.Item{
+					ID:            v.ID,
+					BoardID:       v.BoardID,
+					Title:         v.Title,
+					Description:   v.Description,
+					Completed:     v.Completed,
+					CreatedAt:     v.CreatedAt,
+					LastUpdatedAt: v.LastUpdatedAt,
+					DueAt:         v.DueAt,
+					Priority:      v.Priority,
+					Recurrence:    v.Recurrence,
+					Position:      v.Position,
+					DeletedAt:     v.DeletedAt,
+				},
+				Tags: s.listTagsByItemID(ctx, v.ID),
+			},
+			BoardName: v.BoardName,
+		}
+	}
+	return &items, nil
+}
+
+// RestoreBoard moves board out of the trash to the end of the board listing.
+func (s *Service) RestoreBoard(ctx context.Context, board *Board) error {
+	return s.Repo.RestoreBoardByID(ctx, board.ID)
+}
+
+// RestoreItem moves item out of the trash to the end of its board. A board in
+// the trash is restored along with it, so the item is visible again.
+func (s *Service) RestoreItem(ctx context.Context, item *Item) error {
+	return s.withTx(ctx, func(q *repository.Queries) error {
+		board, err := q.GetBoardByID(ctx, item.BoardID)
+		if err != nil {
+			return err
+		}
+		if board.DeletedAt != nil {
+			if err = q.RestoreBoardByID(ctx, board.ID); err != nil {
+				return err
+			}
+		}
+		return q.RestoreItemByID(ctx, item.ID)
+	})
+}
+
+// PurgeBoard permanently deletes board with all of its items.
+func (s *Service) PurgeBoard(ctx context.Context, board *Board) error {
+	return s.Repo.DeleteBoardByID(ctx, board.ID)
+}
+
+// PurgeItem permanently deletes item with its tags and checklist.
+func (s *Service) PurgeItem(ctx context.Context, item *Item) error {
+	return s.Repo.DeleteItemByID(ctx, item.ID)
+}
+
+// PurgeTrash permanently deletes boards and items that have been in the trash
+// for longer than retention, and returns how many were deleted.
+func (s *Service) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	age := fmt.Sprintf("-%d seconds", int64(retention.Seconds()))
+	boards, err := s.Repo.PurgeDeletedBoards(ctx, age)
+	if err != nil {
+		return 0, err
+	}
+	items, err := s.Repo.PurgeDeletedItems(ctx, age)
+	if err != nil {
+		return 0, err
+	}
+	return boards + items, nil
+}
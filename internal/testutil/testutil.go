@@ -10,9 +10,8 @@ import (
 	"testing"
 
 	_ "github.com/mattn/go-sqlite3" // sqlite driver
-	"github.com/pressly/goose/v3"
 
-	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/migrate"
 	"github.com/rhajizada/donezo/internal/service"
 )
 
@@ -26,34 +25,38 @@ func MustContext() context.Context {
 func NewTestService(t *testing.T) (*service.Service, func()) {
 	t.Helper()
 
-	dbPath := filepath.Join(t.TempDir(), "test.db")
-	db, err := sql.Open("sqlite3", dbPath)
-	if err != nil {
-		t.Fatalf("sql.Open: %v", err)
+	svc, migrator, cleanup := NewTestMigrator(t)
+	if _, err := migrator.Up(MustContext()); err != nil {
+		cleanup()
+		t.Fatalf("migrate up: %v", err)
 	}
+	return svc, cleanup
+}
 
-	baseFS, migrationsDir := migrationsFS(t)
+// NewTestMigrator spins up a temporary SQLite database without running
+// migrations, and returns a Service and a Migrator for it plus a cleanup
+// function.
+func NewTestMigrator(t *testing.T) (*service.Service, *migrate.Migrator, func()) {
+	t.Helper()
 
-	err = goose.SetDialect("sqlite3")
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	db, err := sql.Open("sqlite3", dbPath+"?_foreign_keys=on")
 	if err != nil {
-		t.Fatalf("SetDialect: %v", err)
+		t.Fatalf("sql.Open: %v", err)
 	}
-	goose.SetBaseFS(baseFS)
 
-	err = goose.Up(db, migrationsDir)
+	migrator, err := migrate.New(db, dbPath, migrationsFS(t))
 	if err != nil {
-		t.Fatalf("goose.Up: %v", err)
+		_ = db.Close()
+		t.Fatalf("migrate.New: %v", err)
 	}
 
-	repo := repository.New(db)
-	svc := service.New(repo)
-
-	return svc, func() {
+	return service.New(db), migrator, func() {
 		_ = db.Close()
 	}
 }
 
-func migrationsFS(t *testing.T) (fs.FS, string) {
+func migrationsFS(t *testing.T) fs.FS {
 	t.Helper()
 
 	_, filename, _, ok := runtime.Caller(0)
@@ -62,5 +65,5 @@ func migrationsFS(t *testing.T) (fs.FS, string) {
 	}
 
 	root := filepath.Clean(filepath.Join(filepath.Dir(filename), "..", ".."))
-	return os.DirFS(root), "data/sql/migrations"
+	return os.DirFS(filepath.Join(root, "data", "sql", "migrations"))
 }
@@ -0,0 +1,24 @@
+package activity
+
+import (
+	"charm.land/bubbles/v2/list"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+// Item represents activity log entry in the list.
+type Item struct {
+	Entry service.Activity
+}
+
+func NewList(entries *[]service.Activity) []list.Item {
+	l := make([]list.Item, len(*entries))
+	for i, entry := range *entries {
+		l[i] = Item{Entry: entry}
+	}
+	return l
+}
+
+func (i Item) Title() string       { return i.Entry.Summary() }
+func (i Item) Description() string { return i.Entry.CreatedAt.Local().Format("01-02-2006 15:04") }
+func (i Item) FilterValue() string { return i.Entry.Summary() }
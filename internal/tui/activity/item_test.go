@@ -0,0 +1,51 @@
+package activity_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/activity"
+)
+
+func TestActivityItemAccessors(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 5, 10, 30, 0, 0, time.Local)
+	tests := []struct {
+		name      string
+		entry     repository.Activity
+		wantTitle string
+	}{
+		{
+			name:      "board event",
+			entry:     repository.Activity{Subject: "Inbox", Action: service.ActionCreated},
+			wantTitle: `created board "Inbox"`,
+		},
+		{
+			name: "item event",
+			entry: repository.Activity{
+				ItemID:  sql.NullInt64{Int64: 1, Valid: true},
+				Subject: "task",
+				Action:  service.ActionUntagged,
+				Detail:  "work",
+			},
+			wantTitle: `removed tag "work" from "task"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.entry.CreatedAt = createdAt
+			list := activity.NewList(&[]service.Activity{{Activity: tt.entry}})
+			require.Len(t, list, 1)
+			item := list[0].(activity.Item)
+			assert.Equal(t, tt.wantTitle, item.Title())
+			assert.Equal(t, tt.wantTitle, item.FilterValue())
+			assert.Equal(t, "03-05-2024 10:30", item.Description())
+		})
+	}
+}
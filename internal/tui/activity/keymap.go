@@ -0,0 +1,35 @@
+package activity
+
+import (
+	"charm.land/bubbles/v2/key"
+
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+)
+
+// Keymap embeds default list keymap and adds other Binding.
+type Keymap struct {
+	Back        key.Binding
+	RefreshList key.Binding
+}
+
+func NewKeymap() Keymap {
+	km := Keymap{
+		Back: key.NewBinding(
+			key.WithKeys("backspace"),
+			key.WithHelp("backspace", "back"),
+		),
+		RefreshList: key.NewBinding(key.WithKeys("R"),
+			key.WithHelp("R", "refresh list"),
+		),
+	}
+	keybindings.Apply("activity", &km)
+	return km
+}
+
+func (km Keymap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Back}
+}
+
+func (km Keymap) FullHelp() []key.Binding {
+	return []key.Binding{km.Back, km.RefreshList}
+}
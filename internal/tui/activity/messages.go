@@ -0,0 +1,14 @@
+package activity
+
+import "github.com/rhajizada/donezo/internal/service"
+
+type ErrorMsg struct {
+	Error error
+}
+
+type ListActivityMsg struct {
+	Entries *[]service.Activity
+}
+
+// ClosedMsg is sent when the history panel is closed.
+type ClosedMsg struct{}
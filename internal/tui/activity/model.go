@@ -0,0 +1,116 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/itemlist"
+	"github.com/rhajizada/donezo/internal/tui/navigation"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+// feedLimit caps how many of the most recent entries the feed shows.
+const feedLimit = 500
+
+// MenuModel is the activity feed of all boards and items.
+//
+//nolint:recvcheck // Bubble Tea models intentionally mix value/pointer receivers for tea.Model interface.
+type MenuModel struct {
+	ctx    context.Context
+	List   list.Model
+	Keys   *Keymap
+	Client *service.Service
+}
+
+func (m MenuModel) Init() tea.Cmd {
+	return m.ListActivity()
+}
+
+// New constructs the activity feed.
+func New(ctx context.Context, client *service.Service) MenuModel {
+	list := list.New(
+		[]list.Item{},
+		list.NewDefaultDelegate(),
+		0,
+		0,
+	)
+	keymap := NewKeymap()
+	list.KeyMap = itemlist.DefaultKeyMap().List()
+	list.Title = "Activity"
+	list.SetStatusBarItemName("event", "events")
+	list.AdditionalShortHelpKeys = keymap.ShortHelp
+	list.AdditionalFullHelpKeys = keymap.FullHelp
+	return MenuModel{
+		ctx:    ctx,
+		List:   list,
+		Keys:   &keymap,
+		Client: client,
+	}
+}
+
+// ListActivity fetches the most recent activity.
+func (m *MenuModel) ListActivity() tea.Cmd {
+	return func() tea.Msg {
+		entries, err := m.Client.ListActivity(m.ctx, service.ActivityFilter{Limit: feedLimit})
+		if err != nil {
+			return ErrorMsg{err}
+		}
+		return ListActivityMsg{entries}
+	}
+}
+
+// HandleKeyInput processes key inputs not handles by list.Model.
+func (m *MenuModel) HandleKeyInput(msg tea.KeyPressMsg) tea.Cmd {
+	var cmd tea.Cmd
+	if !m.List.SettingFilter() {
+		switch {
+		case key.Matches(msg, m.Keys.RefreshList):
+			cmd = m.ListActivity()
+		case key.Matches(msg, m.Keys.Back):
+			cmd = func() tea.Msg { return navigation.BackMsg{} }
+		}
+	}
+	return cmd
+}
+
+func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		h, v := styles.App.GetFrameSize()
+		m.List.SetSize(msg.Width-h, msg.Height-v)
+
+	case tea.KeyPressMsg:
+		cmd := m.HandleKeyInput(msg)
+		cmds = append(cmds, cmd)
+
+	case ErrorMsg:
+		cmd := m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(fmt.Sprintf("error: %v", msg.Error)),
+		)
+		cmds = append(cmds, cmd)
+
+	case ListActivityMsg:
+		m.List.SetItems(NewList(msg.Entries))
+	}
+
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && keyMsg.Code == tea.KeyEsc {
+		return m, tea.Batch(cmds...)
+	}
+
+	listModel, listCmd := m.List.Update(msg)
+	m.List = listModel
+	cmds = append(cmds, listCmd)
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m MenuModel) View() tea.View {
+	return tea.NewView(styles.App.Render(m.List.View()))
+}
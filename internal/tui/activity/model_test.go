@@ -0,0 +1,38 @@
+package activity
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/navigation"
+)
+
+func TestActivityFeed(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board, err := svc.CreateBoard(ctx, "Inbox")
+	require.NoError(t, err)
+	_, err = svc.CreateItem(ctx, board, "task", "", nil)
+	require.NoError(t, err)
+
+	menu := New(ctx, svc)
+	model, _ := menu.Update(menu.Init()())
+	menu = model.(MenuModel)
+	require.Len(t, menu.List.Items(), 2)
+	assert.Equal(t, `created item "task"`, menu.List.Items()[0].(Item).Title())
+
+	_, cmd := menu.Update(tea.KeyPressMsg{Code: 'R', Text: "R"})
+	require.NotNil(t, cmd)
+	_, ok := cmd().(ListActivityMsg)
+	assert.True(t, ok)
+
+	_, cmd = menu.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	require.NotNil(t, cmd)
+	assert.Equal(t, navigation.BackMsg{}, cmd())
+}
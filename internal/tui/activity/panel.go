@@ -0,0 +1,71 @@
+package activity
+
+import (
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+const (
+	// The panel takes panelScale/panelScaleBase of the view in each direction.
+	panelScale     = 4
+	panelScaleBase = 5
+	minPanelWidth  = 40
+	minPanelHeight = 10
+)
+
+// Panel is an overlay showing the history of a single item.
+type Panel struct {
+	List   list.Model
+	Close  key.Binding
+	width  int
+	height int
+}
+
+// NewPanel builds a history panel titled after item listing entries.
+func NewPanel(item *service.Item, entries *[]service.Activity) Panel {
+	l := list.New(NewList(entries), list.NewDefaultDelegate(), 0, 0)
+	closeKey := key.NewBinding(
+		key.WithKeys("esc", "backspace", "H"),
+		key.WithHelp("esc", "close"),
+	)
+	l.Title = item.Title + " | History"
+	l.SetStatusBarItemName("event", "events")
+	l.SetFilteringEnabled(false)
+	l.DisableQuitKeybindings()
+	l.AdditionalShortHelpKeys = func() []key.Binding { return []key.Binding{closeKey} }
+	return Panel{
+		List:  l,
+		Close: closeKey,
+	}
+}
+
+// SetSize sets the size of the view the panel is drawn over.
+func (p *Panel) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+	h, v := styles.Overlay.GetFrameSize()
+	p.List.SetSize(
+		max(width*panelScale/panelScaleBase, minPanelWidth)-h,
+		max(height*panelScale/panelScaleBase, minPanelHeight)-v,
+	)
+}
+
+func (p Panel) Update(msg tea.Msg) (Panel, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && key.Matches(keyMsg, p.Close) {
+		return p, func() tea.Msg { return ClosedMsg{} }
+	}
+	var cmd tea.Cmd
+	p.List, cmd = p.List.Update(msg)
+	return p, cmd
+}
+
+// View renders the panel centered in the area given to SetSize.
+func (p Panel) View() string {
+	box := styles.Overlay.Render(p.List.View())
+	return lipgloss.Place(p.width, p.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,49 @@
+package activity_test
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/activity"
+)
+
+func TestHistoryPanel(t *testing.T) {
+	item := service.Item{Item: repository.Item{Title: "task"}}
+	entries := []service.Activity{
+		{Activity: repository.Activity{Subject: "task", Action: service.ActionCompleted}},
+	}
+
+	tests := []struct {
+		name      string
+		msg       tea.KeyPressMsg
+		wantClose bool
+	}{
+		{name: "esc closes", msg: tea.KeyPressMsg{Code: tea.KeyEsc}, wantClose: true},
+		{name: "backspace closes", msg: tea.KeyPressMsg{Code: tea.KeyBackspace}, wantClose: true},
+		{name: "H closes", msg: tea.KeyPressMsg{Code: 'H', Text: "H"}, wantClose: true},
+		{name: "navigation keeps panel open", msg: tea.KeyPressMsg{Code: tea.KeyDown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			panel := activity.NewPanel(&item, &entries)
+			panel.SetSize(100, 40)
+			assert.Contains(t, panel.View(), "task | History")
+
+			_, cmd := panel.Update(tt.msg)
+			if !tt.wantClose {
+				if cmd != nil {
+					assert.NotEqual(t, activity.ClosedMsg{}, cmd())
+				}
+				return
+			}
+			require.NotNil(t, cmd)
+			assert.Equal(t, activity.ClosedMsg{}, cmd())
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/testutil"
 	"github.com/rhajizada/donezo/internal/tui/boards"
+	"github.com/rhajizada/donezo/internal/tui/itemsbyboard"
 	"github.com/rhajizada/donezo/internal/tui/itemsbytag"
 	"github.com/rhajizada/donezo/internal/tui/navigation"
 	"github.com/rhajizada/donezo/internal/tui/tags"
@@ -35,11 +36,11 @@ func TestAppNavigatesBetweenBoardsAndItems(t *testing.T) {
 			defer cleanup()
 
 			board := seedBoard(t, svc, "Inbox")
-			_, err := svc.CreateItem(testutil.MustContext(), board, "task", "desc")
+			_, err := svc.CreateItem(testutil.MustContext(), board, "task", "desc", nil)
 			require.NoError(t, err)
 
 			ctx := testutil.MustContext()
-			m := New(ctx, svc)
+			m := New(ctx, svc, Options{})
 			m.boards.List.SetItems(boards.NewList(&[]service.Board{*board}))
 
 			model, _ := m.Update(navigation.OpenBoardItemsMsg{})
@@ -56,6 +57,145 @@ func TestAppNavigatesBetweenBoardsAndItems(t *testing.T) {
 	}
 }
 
+func TestAppOpensDefaultBoard(t *testing.T) {
+	tests := []struct {
+		name          string
+		defaultBoard  string
+		hideCompleted bool
+		wantView      navigation.View
+	}{
+		{name: "opens board by name ignoring case", defaultBoard: "work", wantView: navigation.ViewItemsByBoard},
+		{name: "hides completed items", defaultBoard: "Work", hideCompleted: true, wantView: navigation.ViewItemsByBoard},
+		{name: "unknown board stays on boards", defaultBoard: "Nope", wantView: navigation.ViewBoards},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			seedBoard(t, svc, "Inbox")
+			seedBoard(t, svc, "Work")
+
+			m := New(testutil.MustContext(), svc, Options{
+				DefaultBoard:  tt.defaultBoard,
+				HideCompleted: tt.hideCompleted,
+			})
+			model, _ := m.Update(m.Init()())
+			am, ok := model.(AppModel)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantView, am.active)
+			assert.Empty(t, am.defaultBoard)
+			if tt.wantView != navigation.ViewItemsByBoard {
+				return
+			}
+			assert.Equal(t, "Work", am.itemsByBoard.List.Title)
+			assert.Equal(t, tt.hideCompleted, am.itemsByBoard.List.HideCompleted())
+		})
+	}
+}
+
+func TestAppOpensChecklistFromBoardItems(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := seedBoard(t, svc, "Inbox")
+	_, err := svc.CreateItem(ctx, board, "task", "desc", nil)
+	require.NoError(t, err)
+	items, err := svc.ListItemsByBoard(ctx, board)
+	require.NoError(t, err)
+
+	m := New(ctx, svc, Options{})
+	m.boards.List.SetItems(boards.NewList(&[]service.Board{*board}))
+	model, _ := m.Update(navigation.OpenBoardItemsMsg{})
+	am := model.(AppModel)
+	am.itemsByBoard.List.SetItems(itemsbyboard.NewList(items, nil))
+	am.itemsByBoard.List.Select(0)
+
+	model, _ = am.Update(navigation.OpenSubtasksMsg{})
+	am = model.(AppModel)
+	assert.Equal(t, navigation.ViewSubtasks, am.active)
+	require.NotNil(t, am.subtasks)
+	assert.Equal(t, "task", am.subtasks.Item.Title)
+
+	model, _ = am.Update(navigation.BackMsg{})
+	am = model.(AppModel)
+	assert.Equal(t, navigation.ViewItemsByBoard, am.active)
+}
+
+func TestAppOpensTrashFromBoards(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board := seedBoard(t, svc, "Inbox")
+	require.NoError(t, svc.DeleteBoard(ctx, board))
+
+	m := New(ctx, svc, Options{})
+	_, cmd := m.Update(tea.KeyPressMsg{Code: 'T', Text: "T"})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, navigation.OpenTrashMsg{}, msg)
+
+	model, _ := m.Update(msg)
+	am := model.(AppModel)
+	assert.Equal(t, navigation.ViewTrash, am.active)
+	require.NotNil(t, am.trash)
+
+	model, _ = am.Update(navigation.BackMsg{})
+	am = model.(AppModel)
+	assert.Equal(t, navigation.ViewBoards, am.active)
+}
+
+func TestAppOpensActivityFeedFromBoards(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	m := New(testutil.MustContext(), svc, Options{})
+	_, cmd := m.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, navigation.OpenActivityMsg{}, msg)
+
+	model, _ := m.Update(msg)
+	am := model.(AppModel)
+	assert.Equal(t, navigation.ViewActivity, am.active)
+	require.NotNil(t, am.activity)
+
+	model, _ = am.Update(navigation.BackMsg{})
+	am = model.(AppModel)
+	assert.Equal(t, navigation.ViewBoards, am.active)
+}
+
+func TestAppOpensTagQueryItems(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+	ctx := testutil.MustContext()
+	board := seedBoard(t, svc, "Inbox")
+	for title, itemTags := range map[string][]string{"a": {"home"}, "b": {"errands", "done"}, "c": {"work"}} {
+		item, err := svc.CreateItem(ctx, board, title, "", nil)
+		require.NoError(t, err)
+		item.Tags = itemTags
+		_, err = svc.UpdateItem(ctx, item)
+		require.NoError(t, err)
+	}
+
+	m := New(ctx, svc, Options{})
+	query := "(home OR errands) AND NOT done"
+	model, cmd := m.Update(navigation.OpenTagQueryMsg{Query: query})
+	am := model.(AppModel)
+	assert.Equal(t, navigation.ViewItemsByTag, am.active)
+	require.NotNil(t, am.itemsByTag)
+	assert.Equal(t, query, am.itemsByTag.List.Title)
+
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(itemsbytag.ListItemsMsg)
+	require.True(t, ok)
+	require.Len(t, *msg.Items, 1)
+	assert.Equal(t, "a", (*msg.Items)[0].Title)
+}
+
 func TestBoardsEscDoesNotQuit(t *testing.T) {
 	tests := []struct {
 		name string
@@ -100,7 +240,7 @@ func TestTagsEscDoesNotQuit(t *testing.T) {
 			defer cleanup()
 
 			board := seedBoard(t, svc, "Inbox")
-			item, err := svc.CreateItem(testutil.MustContext(), board, "task", "desc")
+			item, err := svc.CreateItem(testutil.MustContext(), board, "task", "desc", nil)
 			require.NoError(t, err)
 			item.Tags = []string{tt.tag}
 			_, err = svc.UpdateItem(testutil.MustContext(), item)
@@ -140,13 +280,13 @@ func TestBoardsTabSwitchesToTags(t *testing.T) {
 
 			ctx := testutil.MustContext()
 			board := seedBoard(t, svc, "Inbox")
-			item, err := svc.CreateItem(ctx, board, "task", "desc")
+			item, err := svc.CreateItem(ctx, board, "task", "desc", nil)
 			require.NoError(t, err)
 			item.Tags = []string{tt.tag}
 			_, err = svc.UpdateItem(ctx, item)
 			require.NoError(t, err)
 
-			m := New(ctx, svc)
+			m := New(ctx, svc, Options{})
 			m.boards.List.SetItems(boards.NewList(&[]service.Board{*board}))
 
 			model, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyTab})
@@ -185,12 +325,12 @@ func TestItemsByBoardTabAndShiftTabCycleBoards(t *testing.T) {
 	ctx := testutil.MustContext()
 	board1 := seedBoard(t, svc, "One")
 	board2 := seedBoard(t, svc, "Two")
-	_, err := svc.CreateItem(ctx, board1, "a", "")
+	_, err := svc.CreateItem(ctx, board1, "a", "", nil)
 	require.NoError(t, err)
-	_, err = svc.CreateItem(ctx, board2, "b", "")
+	_, err = svc.CreateItem(ctx, board2, "b", "", nil)
 	require.NoError(t, err)
 
-	m := New(ctx, svc)
+	m := New(ctx, svc, Options{})
 	m.boards.List.SetItems(boards.NewList(&[]service.Board{*board1, *board2}))
 	model, _ := m.Update(navigation.OpenBoardItemsMsg{})
 	appModel := model.(AppModel)
@@ -227,18 +367,18 @@ func TestItemsByTagNextAndPrevious(t *testing.T) {
 	defer cleanup()
 	ctx := testutil.MustContext()
 	board := seedBoard(t, svc, "Inbox")
-	itemA, err := svc.CreateItem(ctx, board, "a", "")
+	itemA, err := svc.CreateItem(ctx, board, "a", "", nil)
 	require.NoError(t, err)
 	itemA.Tags = []string{"alpha"}
 	_, err = svc.UpdateItem(ctx, itemA)
 	require.NoError(t, err)
-	itemB, err := svc.CreateItem(ctx, board, "b", "")
+	itemB, err := svc.CreateItem(ctx, board, "b", "", nil)
 	require.NoError(t, err)
 	itemB.Tags = []string{"beta"}
 	_, err = svc.UpdateItem(ctx, itemB)
 	require.NoError(t, err)
 
-	m := New(ctx, svc)
+	m := New(ctx, svc, Options{})
 	tagCountAlpha, err := svc.CountItemsByTag(ctx, "alpha")
 	require.NoError(t, err)
 	tagCountBeta, err := svc.CountItemsByTag(ctx, "beta")
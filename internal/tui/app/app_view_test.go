@@ -25,7 +25,7 @@ func TestAppInitAndView(t *testing.T) {
 			defer cleanup()
 
 			ctx := testutil.MustContext()
-			m := app.New(ctx, svc)
+			m := app.New(ctx, svc, app.Options{})
 
 			initCmd := m.Init()
 			require.NotNil(t, initCmd)
@@ -40,6 +40,33 @@ func TestAppInitAndView(t *testing.T) {
 	}
 }
 
+func TestAppShowsDatabasePath(t *testing.T) {
+	tests := []struct {
+		name      string
+		dbPath    string
+		wantTitle string
+	}{
+		{name: "without path", wantTitle: "donezo"},
+		{name: "with path", dbPath: "/srv/donezo/data.db", wantTitle: "donezo | /srv/donezo/data.db"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			m := app.New(testutil.MustContext(), svc, app.Options{DBPath: tt.dbPath})
+			model, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+			view := model.View()
+			assert.Equal(t, tt.wantTitle, view.WindowTitle)
+			if tt.dbPath != "" {
+				assert.Contains(t, view.Content, tt.dbPath)
+			}
+		})
+	}
+}
+
 func TestAppWindowSizeUpdatePath(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -55,7 +82,7 @@ func TestAppWindowSizeUpdatePath(t *testing.T) {
 			defer cleanup()
 
 			ctx := testutil.MustContext()
-			m := app.New(ctx, svc)
+			m := app.New(ctx, svc, app.Options{})
 
 			model, _ := m.Update(tea.WindowSizeMsg{Width: tt.width, Height: tt.height})
 			_, ok := model.(app.AppModel)
@@ -1,12 +1,20 @@
 package app
 
 import (
+	"fmt"
+	"strings"
+
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/rhajizada/donezo/internal/tui/activity"
 	"github.com/rhajizada/donezo/internal/tui/boards"
 	"github.com/rhajizada/donezo/internal/tui/itemsbyboard"
 	"github.com/rhajizada/donezo/internal/tui/itemsbytag"
 	"github.com/rhajizada/donezo/internal/tui/navigation"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+	"github.com/rhajizada/donezo/internal/tui/subtasks"
+	"github.com/rhajizada/donezo/internal/tui/tags"
+	"github.com/rhajizada/donezo/internal/tui/trash"
 )
 
 func (m AppModel) switchMain(view navigation.View) (tea.Model, tea.Cmd) {
@@ -25,6 +33,12 @@ func (m AppModel) switchMain(view navigation.View) (tea.Model, tea.Cmd) {
 		return m.openBoardItems()
 	case navigation.ViewItemsByTag:
 		return m.openTagItems()
+	case navigation.ViewSubtasks:
+		return m.openSubtasks()
+	case navigation.ViewTrash:
+		return m.openTrash()
+	case navigation.ViewActivity:
+		return m.openActivity()
 	default:
 		return m, nil
 	}
@@ -38,24 +52,102 @@ func (m AppModel) openBoardItems() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	itemMenu := itemsbyboard.New(m.ctx, m.service, m.boards)
+	hide := m.hideCompleted
+	if m.itemsByBoard != nil {
+		hide = m.itemsByBoard.List.HideCompleted()
+	}
+	itemMenu.List.SetHideCompleted(hide)
 	m.itemsByBoard = &itemMenu
 	m.active = navigation.ViewItemsByBoard
 	return m, m.initWithSize(itemMenu.Init())
 }
 
 func (m AppModel) openTagItems() (tea.Model, tea.Cmd) {
-	if m.tags == nil || m.tags.List.SettingFilter() {
+	if m.tags == nil || m.tags.List.SettingFilter() || m.tags.State != tags.DefaultState {
 		return m, nil
 	}
 	if len(m.tags.List.Items()) == 0 {
 		return m, nil
 	}
 	itemMenu := itemsbytag.New(m.ctx, m.service, m.tags)
+	itemMenu.List.SetHideCompleted(m.tagItemsHidden())
+	m.itemsByTag = &itemMenu
+	m.active = navigation.ViewItemsByTag
+	return m, m.initWithSize(itemMenu.Init())
+}
+
+func (m AppModel) openTagQuery(query string) (tea.Model, tea.Cmd) {
+	if m.tags == nil {
+		return m, nil
+	}
+	itemMenu := itemsbytag.NewQuery(m.ctx, m.service, m.tags, query)
+	itemMenu.List.SetHideCompleted(m.tagItemsHidden())
 	m.itemsByTag = &itemMenu
 	m.active = navigation.ViewItemsByTag
 	return m, m.initWithSize(itemMenu.Init())
 }
 
+// tagItemsHidden reports whether a new tag items view hides completed items,
+// keeping the choice of the previous one.
+func (m AppModel) tagItemsHidden() bool {
+	if m.itemsByTag != nil {
+		return m.itemsByTag.List.HideCompleted()
+	}
+	return m.hideCompleted
+}
+
+// openDefaultBoard opens the board configured to show at startup, once the
+// boards have been loaded.
+func (m AppModel) openDefaultBoard(cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	name := m.defaultBoard
+	m.defaultBoard = ""
+	for i, listItem := range m.boards.List.Items() {
+		if item, ok := listItem.(boards.Item); ok && strings.EqualFold(item.Board.Name, name) {
+			m.boards.List.Select(i)
+			model, openCmd := m.openBoardItems()
+			return model, tea.Batch(cmd, openCmd)
+		}
+	}
+	return m, tea.Batch(cmd, m.boards.List.NewStatusMessage(
+		styles.ErrorMessage.Render(fmt.Sprintf("default board \"%s\" not found", name)),
+	))
+}
+
+func (m AppModel) openSubtasks() (tea.Model, tea.Cmd) {
+	if m.itemsByBoard == nil || m.itemsByBoard.List.SettingFilter() ||
+		m.itemsByBoard.Context.State != itemsbyboard.DefaultState {
+		return m, nil
+	}
+	selected, ok := m.itemsByBoard.List.SelectedItem().(itemsbyboard.Item)
+	if !ok {
+		return m, nil
+	}
+	subtaskMenu := subtasks.New(m.ctx, m.service, &selected.Itm)
+	m.subtasks = &subtaskMenu
+	m.active = navigation.ViewSubtasks
+	return m, m.initWithSize(subtaskMenu.Init())
+}
+
+func (m AppModel) openTrash() (tea.Model, tea.Cmd) {
+	if m.boards == nil || m.boards.List.SettingFilter() || m.boards.State != boards.DefaultState {
+		return m, nil
+	}
+	trashMenu := trash.New(m.ctx, m.service)
+	m.trash = &trashMenu
+	m.active = navigation.ViewTrash
+	return m, m.initWithSize(trashMenu.Init())
+}
+
+func (m AppModel) openActivity() (tea.Model, tea.Cmd) {
+	if m.boards == nil || m.boards.List.SettingFilter() || m.boards.State != boards.DefaultState {
+		return m, nil
+	}
+	activityMenu := activity.New(m.ctx, m.service)
+	m.activity = &activityMenu
+	m.active = navigation.ViewActivity
+	return m, m.initWithSize(activityMenu.Init())
+}
+
 func (m AppModel) navigateBack() (tea.Model, tea.Cmd) {
 	switch m.active {
 	case navigation.ViewItemsByBoard:
@@ -64,6 +156,17 @@ func (m AppModel) navigateBack() (tea.Model, tea.Cmd) {
 	case navigation.ViewItemsByTag:
 		m.active = navigation.ViewTags
 		return m, m.forwardCachedSize()
+	case navigation.ViewSubtasks:
+		// Reload the items so the checklist progress in the footer is current.
+		m.active = navigation.ViewItemsByBoard
+		return m, m.initWithSize(m.itemsByBoard.Init())
+	case navigation.ViewTrash:
+		// Reload the boards so restored boards show up.
+		m.active = navigation.ViewBoards
+		return m, m.initWithSize(m.boards.Init())
+	case navigation.ViewActivity:
+		m.active = navigation.ViewBoards
+		return m, m.forwardCachedSize()
 	case navigation.ViewBoards, navigation.ViewTags:
 		return m, nil
 	default:
@@ -94,7 +197,7 @@ func (m AppModel) moveBoardSelection(delta int) (tea.Model, tea.Cmd) {
 }
 
 func (m AppModel) moveTagSelection(delta int) (tea.Model, tea.Cmd) {
-	if m.tags == nil || m.tags.List.SettingFilter() {
+	if m.tags == nil || m.tags.List.SettingFilter() || m.tags.State != tags.DefaultState {
 		return m, nil
 	}
 	items := m.tags.List.Items()
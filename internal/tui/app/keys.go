@@ -0,0 +1,107 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/tui/activity"
+	"github.com/rhajizada/donezo/internal/tui/boards"
+	"github.com/rhajizada/donezo/internal/tui/itemlist"
+	"github.com/rhajizada/donezo/internal/tui/itemsbyboard"
+	"github.com/rhajizada/donezo/internal/tui/itemsbytag"
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+	"github.com/rhajizada/donezo/internal/tui/subtasks"
+	"github.com/rhajizada/donezo/internal/tui/tags"
+	"github.com/rhajizada/donezo/internal/tui/trash"
+)
+
+// overlaps are the actions sharing keys by design: the help toggles, and the
+// keys of the filter input, which is the only thing receiving keys while a
+// filter is typed.
+//
+//nolint:gochecknoglobals // static allow-list
+var overlaps = []keybindings.Overlap{
+	{"showFullHelp", "closeFullHelp"},
+	{"clearFilter", "cancelWhileFiltering"},
+	{"acceptWhileFiltering", "cursorUp"},
+	{"acceptWhileFiltering", "cursorDown"},
+	{"acceptWhileFiltering", "nextBoard"},
+	{"acceptWhileFiltering", "previousBoard"},
+	{"acceptWhileFiltering", "choose"},
+	{"acceptWhileFiltering", "listTags"},
+	{"acceptWhileFiltering", "listBoards"},
+}
+
+// keymaps returns the configurable keymaps by view name, along with the
+// keymaps active next to them in that view.
+func keymaps() map[string][]keybindings.Keymap {
+	boardKeys := boards.NewKeymap()
+	tagKeys := tags.NewKeymap()
+	subtaskKeys := subtasks.NewKeymap()
+	trashKeys := trash.NewKeymap()
+	activityKeys := activity.NewKeymap()
+	listKeys := itemlist.DefaultKeyMap()
+	list := keybindings.Keymap{View: "list", Bindings: &listKeys}
+	// The other views run on a bubbles list, given the same keys.
+	menuKeys := listKeys.List()
+	menu := keybindings.Keymap{View: "list", Bindings: &menuKeys}
+	return map[string][]keybindings.Keymap{
+		"boards":   {{View: "boards", Bindings: &boardKeys}, menu},
+		"tags":     {{View: "tags", Bindings: &tagKeys}, menu},
+		"items":    {{View: "items", Bindings: itemsbyboard.NewKeymap()}, list},
+		"tagItems": {{View: "tagItems", Bindings: itemsbytag.NewKeymap()}, list},
+		"subtasks": {{View: "subtasks", Bindings: &subtaskKeys}, menu},
+		"trash":    {{View: "trash", Bindings: &trashKeys}, menu},
+		"activity": {{View: "activity", Bindings: &activityKeys}, menu},
+		"list":     {list},
+	}
+}
+
+// ConfigureKeys applies keybinding overrides by view and action name. Unknown
+// views or actions and keys bound to several actions of a view are reported
+// together.
+func ConfigureKeys(overrides map[string]keybindings.Overrides) error {
+	defaults := keymaps()
+	var errs []error
+	for _, view := range slices.Sorted(maps.Keys(overrides)) {
+		km, ok := defaults[view]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown view %q, expected one of %s", view, strings.Join(viewNames(), ", ")))
+			continue
+		}
+		actions := keybindings.Actions(km[0].Bindings)
+		for action, keys := range overrides[view] {
+			if !slices.Contains(actions, action) {
+				errs = append(errs, fmt.Errorf(
+					"%s: unknown action %q, expected one of %s", view, action, strings.Join(actions, ", "),
+				))
+			}
+			if slices.Contains(keys, "") {
+				errs = append(errs, fmt.Errorf("%s: %s has an empty key", view, action))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	keybindings.Configure(overrides)
+	configured := keymaps()
+	for _, view := range viewNames() {
+		for _, conflict := range keybindings.Conflicts(view, overlaps, configured[view]...) {
+			errs = append(errs, errors.New(conflict.String()))
+		}
+	}
+	if len(errs) > 0 {
+		keybindings.Configure(nil)
+		return fmt.Errorf("conflicting keybindings:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func viewNames() []string {
+	return slices.Sorted(maps.Keys(keymaps()))
+}
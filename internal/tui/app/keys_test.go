@@ -0,0 +1,92 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/boards"
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+)
+
+func TestConfigureKeys(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]keybindings.Overrides
+		wantErr   []string
+	}{
+		{name: "defaults have no conflicts"},
+		{
+			name:      "override applies to new keymaps",
+			overrides: map[string]keybindings.Overrides{"boards": {"createBoard": {"n"}}},
+		},
+		{
+			name: "unknown view and action",
+			overrides: map[string]keybindings.Overrides{
+				"board":  {"createBoard": {"n"}},
+				"boards": {"create": {"n"}},
+			},
+			wantErr: []string{`unknown view "board"`, `boards: unknown action "create"`},
+		},
+		{
+			name: "conflicts within a view",
+			overrides: map[string]keybindings.Overrides{
+				"boards": {"createBoard": {"d"}},
+				"items":  {"back": {"j"}},
+			},
+			wantErr: []string{
+				`boards: "d" is bound to createBoard and deleteBoard`,
+				`items: "j" is bound to back and list.cursorDown`,
+			},
+		},
+		{
+			name:      "overrides clashing with default list keys",
+			overrides: map[string]keybindings.Overrides{"items": {"editBlockers": {"b"}}},
+			wantErr:   []string{`items: "b" is bound to editBlockers and list.prevPage`},
+		},
+		{
+			name: "list keys clashing with views on a bubbles list",
+			overrides: map[string]keybindings.Overrides{
+				"list":  {"nextPage": {"d"}},
+				"trash": {"restore": {"h"}},
+			},
+			wantErr: []string{
+				`boards: "d" is bound to deleteBoard and list.nextPage`,
+				`subtasks: "d" is bound to deleteSubtask and list.nextPage`,
+				`tags: "d" is bound to deleteTag and list.nextPage`,
+				`trash: "d" is bound to purge and list.nextPage`,
+				`trash: "h" is bound to restore and list.prevPage`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Cleanup(func() { keybindings.Configure(nil) })
+
+			err := ConfigureKeys(tt.overrides)
+			if len(tt.wantErr) > 0 {
+				require.Error(t, err)
+				for _, want := range tt.wantErr {
+					assert.Contains(t, err.Error(), want)
+				}
+				assert.Equal(t, []string{"a"}, boards.NewKeymap().CreateBoard.Keys())
+				return
+			}
+			require.NoError(t, err)
+			if keys, ok := tt.overrides["boards"]["createBoard"]; ok {
+				assert.Equal(t, keys, boards.NewKeymap().CreateBoard.Keys())
+			}
+		})
+	}
+}
+
+func TestListKeysApplyToEveryList(t *testing.T) {
+	t.Cleanup(func() { keybindings.Configure(nil) })
+	require.NoError(t, ConfigureKeys(map[string]keybindings.Overrides{"list": {"nextPage": {"n"}}}))
+
+	menu := boards.New(testutil.MustContext(), nil)
+	assert.Equal(t, []string{"n"}, menu.List.KeyMap.NextPage.Keys())
+}
@@ -5,12 +5,16 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/rhajizada/donezo/internal/paths"
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/activity"
 	"github.com/rhajizada/donezo/internal/tui/boards"
 	"github.com/rhajizada/donezo/internal/tui/itemsbyboard"
 	"github.com/rhajizada/donezo/internal/tui/itemsbytag"
 	"github.com/rhajizada/donezo/internal/tui/navigation"
+	"github.com/rhajizada/donezo/internal/tui/subtasks"
 	"github.com/rhajizada/donezo/internal/tui/tags"
+	"github.com/rhajizada/donezo/internal/tui/trash"
 )
 
 //revive:disable-next-line:exported // app.AppModel is the public entry point for the TUI.
@@ -23,20 +27,46 @@ type AppModel struct {
 	tags         *tags.MenuModel
 	itemsByBoard *itemsbyboard.MenuModel
 	itemsByTag   *itemsbytag.MenuModel
+	subtasks     *subtasks.MenuModel
+	trash        *trash.MenuModel
+	activity     *activity.MenuModel
 
 	active   navigation.View
 	lastSize *tea.WindowSizeMsg
+	title    string
+
+	defaultBoard  string
+	hideCompleted bool
+}
+
+// Options configures the TUI.
+type Options struct {
+	// DBPath is the database in use, shown in the window and board titles.
+	DBPath string
+	// DefaultBoard is the name of the board to open once boards are loaded.
+	DefaultBoard string
+	// HideCompleted hides completed items in the item views until toggled.
+	HideCompleted bool
 }
 
-func New(ctx context.Context, service *service.Service) AppModel {
+func New(ctx context.Context, service *service.Service, opts Options) AppModel {
 	boardMenu := boards.New(ctx, service)
 	tagMenu := tags.NewModel(ctx, service)
+	title := "donezo"
+	if opts.DBPath != "" {
+		title += " | " + paths.Display(opts.DBPath)
+		boardMenu.List.Title += " | " + paths.Display(opts.DBPath)
+	}
 	return AppModel{
 		ctx:     ctx,
 		service: service,
 		boards:  &boardMenu,
 		tags:    &tagMenu,
 		active:  navigation.ViewBoards,
+		title:   title,
+
+		defaultBoard:  opts.DefaultBoard,
+		hideCompleted: opts.HideCompleted,
 	}
 }
 
@@ -3,11 +3,14 @@ package app
 import (
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/rhajizada/donezo/internal/tui/activity"
 	"github.com/rhajizada/donezo/internal/tui/boards"
 	"github.com/rhajizada/donezo/internal/tui/itemsbyboard"
 	"github.com/rhajizada/donezo/internal/tui/itemsbytag"
 	"github.com/rhajizada/donezo/internal/tui/navigation"
+	"github.com/rhajizada/donezo/internal/tui/subtasks"
 	"github.com/rhajizada/donezo/internal/tui/tags"
+	"github.com/rhajizada/donezo/internal/tui/trash"
 )
 
 func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -21,6 +24,14 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.openBoardItems()
 	case navigation.OpenTagItemsMsg:
 		return m.openTagItems()
+	case navigation.OpenTagQueryMsg:
+		return m.openTagQuery(msg.Query)
+	case navigation.OpenSubtasksMsg:
+		return m.openSubtasks()
+	case navigation.OpenTrashMsg:
+		return m.openTrash()
+	case navigation.OpenActivityMsg:
+		return m.openActivity()
 	case navigation.BackMsg:
 		return m.navigateBack()
 	case navigation.BoardDeltaMsg:
@@ -36,6 +47,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	updated, cmd := active.Update(msg)
 	m.setActiveModel(updated)
+	if _, ok := msg.(boards.ListBoardsMsg); ok && m.defaultBoard != "" {
+		return m.openDefaultBoard(cmd)
+	}
 	return m, cmd
 }
 
@@ -79,6 +93,27 @@ func (m *AppModel) setActiveModel(model tea.Model) {
 		case *itemsbytag.MenuModel:
 			m.itemsByTag = v
 		}
+	case navigation.ViewSubtasks:
+		switch v := model.(type) {
+		case subtasks.MenuModel:
+			m.subtasks = &v
+		case *subtasks.MenuModel:
+			m.subtasks = v
+		}
+	case navigation.ViewTrash:
+		switch v := model.(type) {
+		case trash.MenuModel:
+			m.trash = &v
+		case *trash.MenuModel:
+			m.trash = v
+		}
+	case navigation.ViewActivity:
+		switch v := model.(type) {
+		case activity.MenuModel:
+			m.activity = &v
+		case *activity.MenuModel:
+			m.activity = v
+		}
 	}
 }
 
@@ -96,6 +131,18 @@ func (m *AppModel) activeModel() tea.Model {
 		if m.itemsByTag != nil {
 			return m.itemsByTag
 		}
+	case navigation.ViewSubtasks:
+		if m.subtasks != nil {
+			return m.subtasks
+		}
+	case navigation.ViewTrash:
+		if m.trash != nil {
+			return m.trash
+		}
+	case navigation.ViewActivity:
+		if m.activity != nil {
+			return m.activity
+		}
 	}
 	return nil
 }
@@ -9,5 +9,6 @@ func (m AppModel) View() tea.View {
 	}
 	view := active.View()
 	view.AltScreen = true
+	view.WindowTitle = m.title
 	return view
 }
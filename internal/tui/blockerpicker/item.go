@@ -0,0 +1,22 @@
+package blockerpicker
+
+import (
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+// Item is a candidate blocker in the picker.
+type Item struct {
+	Itm       service.Item
+	BoardName string
+	Blocking  bool
+}
+
+func (i Item) Title() string {
+	box := "[ ]"
+	if i.Blocking {
+		box = "[x]"
+	}
+	return box + " " + i.Itm.Title
+}
+func (i Item) Description() string { return i.BoardName }
+func (i Item) FilterValue() string { return i.Itm.Title }
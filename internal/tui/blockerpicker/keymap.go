@@ -0,0 +1,25 @@
+package blockerpicker
+
+import "charm.land/bubbles/v2/key"
+
+type Keymap struct {
+	Toggle key.Binding
+	Close  key.Binding
+}
+
+func NewKeymap() Keymap {
+	return Keymap{
+		Toggle: key.NewBinding(
+			key.WithKeys("enter", "space"),
+			key.WithHelp("enter", "toggle blocker"),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "close"),
+		),
+	}
+}
+
+func (km Keymap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Toggle, km.Close}
+}
@@ -0,0 +1,13 @@
+package blockerpicker
+
+import "github.com/rhajizada/donezo/internal/service"
+
+// ToggledMsg is sent when a candidate was chosen. Blocking is the requested
+// state: true to add it as a blocker, false to remove it.
+type ToggledMsg struct {
+	Blocker  service.Item
+	Blocking bool
+}
+
+// ClosedMsg is sent when the picker was closed.
+type ClosedMsg struct{}
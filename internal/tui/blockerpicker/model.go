@@ -0,0 +1,124 @@
+package blockerpicker
+
+import (
+	"context"
+	"slices"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+const (
+	// overlayWidthRatio and overlayHeightRatio size the picker relative to the view.
+	overlayWidthRatio  = 2
+	overlayHeightRatio = 2
+	minOverlayWidth    = 40
+	minOverlayHeight   = 12
+)
+
+// Model is an overlay that lets the user choose which items block an item.
+type Model struct {
+	List   list.Model
+	Keys   Keymap
+	width  int
+	height int
+}
+
+// Load builds a picker for item listing the items of all boards, with the
+// current blockers of item checked.
+func Load(ctx context.Context, svc *service.Service, item *service.Item) (Model, error) {
+	blockerIDs, err := svc.ListBlockerIDs(ctx, item)
+	if err != nil {
+		return Model{}, err
+	}
+	boardList, err := svc.ListBoards(ctx)
+	if err != nil {
+		return Model{}, err
+	}
+	var candidates []list.Item
+	for _, board := range *boardList {
+		items, listErr := svc.ListItemsByBoard(ctx, &board)
+		if listErr != nil {
+			return Model{}, listErr
+		}
+		for _, candidate := range *items {
+			if candidate.ID == item.ID {
+				continue
+			}
+			candidates = append(candidates, Item{
+				Itm:       candidate,
+				BoardName: board.Name,
+				Blocking:  slices.Contains(blockerIDs, candidate.ID),
+			})
+		}
+	}
+	return New(item, candidates), nil
+}
+
+// New builds a picker for item listing candidates.
+func New(item *service.Item, candidates []list.Item) Model {
+	l := list.New(candidates, list.NewDefaultDelegate(), 0, 0)
+	keymap := NewKeymap()
+	l.Title = item.Title + " | Blocked by"
+	l.SetStatusBarItemName("item", "items")
+	l.DisableQuitKeybindings()
+	l.AdditionalShortHelpKeys = keymap.ShortHelp
+	return Model{
+		List: l,
+		Keys: keymap,
+	}
+}
+
+// SetSize sets the size of the view the picker is drawn over.
+func (m *Model) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	h, v := styles.Overlay.GetFrameSize()
+	m.List.SetSize(
+		max(width/overlayWidthRatio, minOverlayWidth)-h,
+		max(height/overlayHeightRatio, minOverlayHeight)-v,
+	)
+}
+
+// SetBlocking checks or unchecks the candidate with id.
+func (m *Model) SetBlocking(id int64, blocking bool) {
+	for i, li := range m.List.Items() {
+		if candidate, ok := li.(Item); ok && candidate.Itm.ID == id {
+			candidate.Blocking = blocking
+			m.List.SetItem(i, candidate)
+			return
+		}
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && !m.List.SettingFilter() {
+		switch {
+		case key.Matches(keyMsg, m.Keys.Toggle):
+			selected, isItem := m.List.SelectedItem().(Item)
+			if !isItem {
+				return m, nil
+			}
+			return m, func() tea.Msg {
+				return ToggledMsg{Blocker: selected.Itm, Blocking: !selected.Blocking}
+			}
+		case key.Matches(keyMsg, m.Keys.Close) && m.List.FilterState() == list.Unfiltered:
+			return m, func() tea.Msg { return ClosedMsg{} }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.List, cmd = m.List.Update(msg)
+	return m, cmd
+}
+
+// View renders the picker centered in the area given to SetSize.
+func (m Model) View() string {
+	box := styles.Overlay.Render(m.List.View())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,91 @@
+package blockerpicker_test
+
+import (
+	"testing"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/blockerpicker"
+)
+
+func newPicker() blockerpicker.Model {
+	item := service.Item{Item: repository.Item{ID: 1, Title: "ship"}}
+	candidates := []list.Item{
+		blockerpicker.Item{Itm: service.Item{Item: repository.Item{ID: 2, Title: "build"}}, BoardName: "Work"},
+		blockerpicker.Item{
+			Itm:       service.Item{Item: repository.Item{ID: 3, Title: "test"}},
+			BoardName: "Work",
+			Blocking:  true,
+		},
+	}
+	picker := blockerpicker.New(&item, candidates)
+	picker.SetSize(100, 40)
+	return picker
+}
+
+func TestBlockerPicker(t *testing.T) {
+	tests := []struct {
+		name      string
+		selected  int
+		msg       tea.KeyPressMsg
+		assertMsg func(*testing.T, tea.Msg)
+	}{
+		{
+			name: "enter checks unchecked item",
+			msg:  tea.KeyPressMsg{Code: tea.KeyEnter},
+			assertMsg: func(t *testing.T, msg tea.Msg) {
+				toggled, ok := msg.(blockerpicker.ToggledMsg)
+				require.True(t, ok)
+				assert.Equal(t, "build", toggled.Blocker.Title)
+				assert.True(t, toggled.Blocking)
+			},
+		},
+		{
+			name:     "space unchecks checked item",
+			selected: 1,
+			msg:      tea.KeyPressMsg{Code: tea.KeySpace, Text: " "},
+			assertMsg: func(t *testing.T, msg tea.Msg) {
+				toggled, ok := msg.(blockerpicker.ToggledMsg)
+				require.True(t, ok)
+				assert.Equal(t, "test", toggled.Blocker.Title)
+				assert.False(t, toggled.Blocking)
+			},
+		},
+		{
+			name: "esc closes",
+			msg:  tea.KeyPressMsg{Code: tea.KeyEsc},
+			assertMsg: func(t *testing.T, msg tea.Msg) {
+				assert.Equal(t, blockerpicker.ClosedMsg{}, msg)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			picker := newPicker()
+			picker.List.Select(tt.selected)
+			_, cmd := picker.Update(tt.msg)
+			require.NotNil(t, cmd)
+			tt.assertMsg(t, cmd())
+		})
+	}
+
+	t.Run("set blocking updates check mark", func(t *testing.T) {
+		picker := newPicker()
+		view := picker.View()
+		assert.Contains(t, view, "ship | Blocked by")
+		assert.Contains(t, view, "[ ] build")
+		assert.Contains(t, view, "[x] test")
+
+		picker.SetBlocking(2, true)
+		picker.SetBlocking(3, false)
+		view = picker.View()
+		assert.Contains(t, view, "[x] build")
+		assert.Contains(t, view, "[ ] test")
+	})
+}
@@ -0,0 +1,25 @@
+package boardpicker
+
+import "charm.land/bubbles/v2/key"
+
+type Keymap struct {
+	Choose key.Binding
+	Cancel key.Binding
+}
+
+func NewKeymap() Keymap {
+	return Keymap{
+		Choose: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "move here"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+	}
+}
+
+func (km Keymap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Choose, km.Cancel}
+}
@@ -0,0 +1,11 @@
+package boardpicker
+
+import "github.com/rhajizada/donezo/internal/service"
+
+// PickedMsg is sent when a board was chosen.
+type PickedMsg struct {
+	Board service.Board
+}
+
+// CancelledMsg is sent when the picker was closed without choosing a board.
+type CancelledMsg struct{}
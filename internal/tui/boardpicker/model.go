@@ -0,0 +1,93 @@
+package boardpicker
+
+import (
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/boards"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+const (
+	// overlayWidthRatio and overlayHeightRatio size the picker relative to the view.
+	overlayWidthRatio  = 2
+	overlayHeightRatio = 2
+	minOverlayWidth    = 30
+	minOverlayHeight   = 10
+)
+
+// Model is an overlay that lets the user choose a target board.
+type Model struct {
+	List   list.Model
+	Keys   Keymap
+	width  int
+	height int
+}
+
+// New builds a picker listing boards except the one with excludeID.
+func New(boardList *[]service.Board, excludeID int64) Model {
+	choices := make([]service.Board, 0, len(*boardList))
+	for _, b := range *boardList {
+		if b.ID != excludeID {
+			choices = append(choices, b)
+		}
+	}
+
+	delegate := list.NewDefaultDelegate()
+	delegate.ShowDescription = false
+	l := list.New(boards.NewList(&choices), delegate, 0, 0)
+	keymap := NewKeymap()
+	l.Title = "Move to board"
+	l.SetStatusBarItemName("board", "boards")
+	l.DisableQuitKeybindings()
+	l.AdditionalShortHelpKeys = keymap.ShortHelp
+	return Model{
+		List: l,
+		Keys: keymap,
+	}
+}
+
+// SetSize sets the size of the view the picker is drawn over.
+func (m *Model) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	h, v := styles.Overlay.GetFrameSize()
+	m.List.SetSize(
+		max(width/overlayWidthRatio, minOverlayWidth)-h,
+		max(height/overlayHeightRatio, minOverlayHeight)-v,
+	)
+}
+
+// Selected returns the highlighted board.
+func (m Model) Selected() (service.Board, bool) {
+	item, ok := m.List.SelectedItem().(boards.Item)
+	return item.Board, ok
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && !m.List.SettingFilter() {
+		switch {
+		case key.Matches(keyMsg, m.Keys.Choose):
+			board, selected := m.Selected()
+			if !selected {
+				return m, nil
+			}
+			return m, func() tea.Msg { return PickedMsg{Board: board} }
+		case key.Matches(keyMsg, m.Keys.Cancel) && m.List.FilterState() == list.Unfiltered:
+			return m, func() tea.Msg { return CancelledMsg{} }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.List, cmd = m.List.Update(msg)
+	return m, cmd
+}
+
+// View renders the picker centered in the area given to SetSize.
+func (m Model) View() string {
+	box := styles.Overlay.Render(m.List.View())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
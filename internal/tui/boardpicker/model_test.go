@@ -0,0 +1,67 @@
+package boardpicker_test
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/boardpicker"
+)
+
+func newPicker() boardpicker.Model {
+	boards := []service.Board{
+		{Board: repository.Board{ID: 1, Name: "Inbox"}},
+		{Board: repository.Board{ID: 2, Name: "Work"}},
+		{Board: repository.Board{ID: 3, Name: "Home"}},
+	}
+	picker := boardpicker.New(&boards, 1)
+	picker.SetSize(100, 40)
+	return picker
+}
+
+func TestBoardPicker(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       tea.KeyPressMsg
+		assertMsg func(*testing.T, tea.Msg)
+	}{
+		{
+			name: "enter picks highlighted board",
+			msg:  tea.KeyPressMsg{Code: tea.KeyEnter},
+			assertMsg: func(t *testing.T, msg tea.Msg) {
+				picked, ok := msg.(boardpicker.PickedMsg)
+				require.True(t, ok)
+				assert.Equal(t, "Work", picked.Board.Name)
+			},
+		},
+		{
+			name: "esc cancels",
+			msg:  tea.KeyPressMsg{Code: tea.KeyEsc},
+			assertMsg: func(t *testing.T, msg tea.Msg) {
+				assert.Equal(t, boardpicker.CancelledMsg{}, msg)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			picker := newPicker()
+			_, cmd := picker.Update(tt.msg)
+			require.NotNil(t, cmd)
+			tt.assertMsg(t, cmd())
+		})
+	}
+
+	t.Run("current board is excluded", func(t *testing.T) {
+		picker := newPicker()
+		assert.Len(t, picker.List.Items(), 2)
+		view := picker.View()
+		assert.Contains(t, view, "Work")
+		assert.Contains(t, view, "Home")
+		assert.NotContains(t, view, "Inbox")
+	})
+}
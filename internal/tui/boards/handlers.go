@@ -2,10 +2,12 @@ package boards
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/rhajizada/donezo/internal/tui/styles"
 
 	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
 	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 
@@ -57,7 +59,7 @@ func (m *MenuModel) HandleDeleteBoard(msg DeleteBoardMsg) tea.Cmd {
 
 	return m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
-			fmt.Sprintf("deleted board \"%s\"", msg.Board.Name),
+			fmt.Sprintf("moved board \"%s\" to trash", msg.Board.Name),
 		),
 	)
 }
@@ -79,13 +81,66 @@ func (m *MenuModel) HandleRenameBoard(msg RenameBoardMsg) tea.Cmd {
 	)
 }
 
-// HandleInputState handles CreateBoardState and RenameBoardState states.
+// HandleSetDefaultTags handles SetDefaultTagsMsg.
+func (m *MenuModel) HandleSetDefaultTags(msg SetDefaultTagsMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed updating default tags: %v", msg.Error),
+			),
+		)
+	}
+
+	if idx := slices.IndexFunc(m.List.Items(), func(i list.Item) bool {
+		item, ok := i.(Item)
+		return ok && item.Board.ID == msg.Board.ID
+	}); idx >= 0 {
+		m.List.SetItem(idx, NewItem(msg.Board))
+	}
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("updated default tags of \"%s\"", msg.Board.Name),
+		),
+	)
+}
+
+func (m *MenuModel) HandleMoveBoard(msg MoveBoardMsg) tea.Cmd {
+	if msg.Error != nil {
+		// The list was reordered optimistically, reload it to match the database.
+		return tea.Batch(
+			m.List.NewStatusMessage(
+				styles.ErrorMessage.Render(
+					fmt.Sprintf("failed moving board: %v", msg.Error),
+				),
+			),
+			m.ListBoards(),
+		)
+	}
+	return nil
+}
+
+// HandleInputState handles CreateBoardState, RenameBoardState and
+// DefaultTagsState states.
 func (m *MenuModel) HandleInputState(msg tea.Msg) (textinput.Model, []tea.Cmd) {
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && m.State == DefaultTagsState {
+		var value string
+		var completed bool
+		m.Completer, value, completed = m.Completer.Update(keyMsg, m.Input.Value())
+		if completed {
+			m.Input.SetValue(value)
+			m.Input.CursorEnd()
+			return m.Input, nil
+		}
+	}
+
 	m.Input, cmd = m.Input.Update(msg)
 	cmds = append(cmds, cmd)
+	if m.State == DefaultTagsState {
+		m.Completer.SetValue(m.Input.Value())
+	}
 
 	// Only handle key messages in input states
 	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
@@ -100,6 +155,10 @@ func (m *MenuModel) HandleInputState(msg tea.Msg) (textinput.Model, []tea.Cmd) {
 				cmds = append(cmds, m.RenameBoard())
 				m.State = DefaultState
 				m.Input.Blur()
+			case DefaultTagsState:
+				cmds = append(cmds, m.SetDefaultTags())
+				m.State = DefaultState
+				m.Input.Blur()
 			case DefaultState:
 				// no-op
 			}
@@ -126,8 +185,22 @@ func (m *MenuModel) HandleKeyInput(msg tea.KeyPressMsg) tea.Cmd {
 			cmd = m.DeleteBoard()
 		case key.Matches(msg, m.Keys.RenameBoard):
 			cmd = m.InitRenameBoard()
+		case key.Matches(msg, m.Keys.DefaultTags):
+			cmd = m.InitDefaultTags()
+		case key.Matches(msg, m.Keys.MoveUp):
+			cmd = m.MoveBoard(-1)
+		case key.Matches(msg, m.Keys.MoveDown):
+			cmd = m.MoveBoard(1)
 		case key.Matches(msg, m.Keys.RefreshList):
 			cmd = m.ListBoards()
+		case key.Matches(msg, m.Keys.ShowTrash):
+			cmd = func() tea.Msg {
+				return navigation.OpenTrashMsg{}
+			}
+		case key.Matches(msg, m.Keys.ShowActivity):
+			cmd = func() tea.Msg {
+				return navigation.OpenActivityMsg{}
+			}
 		case key.Matches(msg, m.Keys.Copy):
 			cmd = m.Copy()
 		case key.Matches(msg, m.Keys.ListTags):
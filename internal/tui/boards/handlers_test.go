@@ -9,6 +9,7 @@ import (
 
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
 	"github.com/rhajizada/donezo/internal/tui/navigation"
 	"github.com/rhajizada/donezo/internal/tui/styles"
 )
@@ -118,14 +119,13 @@ func TestBoardsKeyBindings(t *testing.T) {
 		menu, cleanup := newBoardMenu(t)
 		defer cleanup()
 
-		var captured []byte
-		prev := writeClipboardText
-		writeClipboardText = func(data []byte) { captured = append([]byte{}, data...) }
-		defer func() { writeClipboardText = prev }()
+		menu.Clipboard = clipboard.NewRegister("")
 
 		_, cmd := menu.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
 		require.NotNil(t, cmd)
 		cmd()
+		captured, err := menu.Clipboard.Read()
+		require.NoError(t, err)
 		assert.NotEmpty(t, captured)
 	})
 }
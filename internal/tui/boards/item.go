@@ -1,6 +1,8 @@
 package boards
 
 import (
+	"strings"
+
 	"charm.land/bubbles/v2/list"
 
 	"github.com/rhajizada/donezo/internal/service"
@@ -25,6 +27,12 @@ func NewItem(board *service.Board) list.Item {
 	}
 }
 
-func (i Item) Title() string       { return i.Board.Name }
-func (i Item) Description() string { return i.Board.CreatedAt.Format("01-02-2006 15:04") }
+func (i Item) Title() string { return i.Board.Name }
+func (i Item) Description() string {
+	desc := i.Board.CreatedAt.Format("01-02-2006 15:04")
+	if len(i.Board.DefaultTags) > 0 {
+		desc += " | default tags: " + strings.Join(i.Board.DefaultTags, ", ")
+	}
+	return desc
+}
 func (i Item) FilterValue() string { return i.Board.Name }
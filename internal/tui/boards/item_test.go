@@ -34,6 +34,10 @@ func TestBoardItemAccessors(t *testing.T) {
 
 			list := boards.NewList(&[]service.Board{*board})
 			assert.Len(t, list, 1)
+
+			board.DefaultTags = []string{"home", "work"}
+			item = boards.NewItem(board).(boards.Item)
+			assert.Contains(t, item.Description(), " | default tags: home, work")
 		})
 	}
 }
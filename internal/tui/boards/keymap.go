@@ -2,6 +2,8 @@ package boards
 
 import (
 	"charm.land/bubbles/v2/key"
+
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
 )
 
 // Keymap embeds default list keymap and adds other Binding.
@@ -11,14 +13,19 @@ type Keymap struct {
 	CreateBoard   key.Binding
 	DeleteBoard   key.Binding
 	RenameBoard   key.Binding
+	DefaultTags   key.Binding
+	MoveUp        key.Binding
+	MoveDown      key.Binding
 	RefreshList   key.Binding
+	ShowTrash     key.Binding
+	ShowActivity  key.Binding
 	Copy          key.Binding
 	NextBoard     key.Binding
 	PreviousBoard key.Binding
 }
 
 func NewKeymap() Keymap {
-	return Keymap{
+	km := Keymap{
 		Choose: key.NewBinding(
 			key.WithKeys("enter", "return"),
 			key.WithHelp("enter", "choose board"),
@@ -37,13 +44,30 @@ func NewKeymap() Keymap {
 		RenameBoard: key.NewBinding(key.WithKeys("r"),
 			key.WithHelp("r", "rename board"),
 		),
+		DefaultTags: key.NewBinding(key.WithKeys("t"),
+			key.WithHelp("t", "edit default tags"),
+		),
+		MoveUp: key.NewBinding(key.WithKeys("K"),
+			key.WithHelp("K", "move board up"),
+		),
+		MoveDown: key.NewBinding(key.WithKeys("J"),
+			key.WithHelp("J", "move board down"),
+		),
 		RefreshList: key.NewBinding(key.WithKeys("R"),
 			key.WithHelp("R", "refresh list"),
 		),
+		ShowTrash: key.NewBinding(key.WithKeys("T"),
+			key.WithHelp("T", "show trash"),
+		),
+		ShowActivity: key.NewBinding(key.WithKeys("H"),
+			key.WithHelp("H", "show activity"),
+		),
 		Copy: key.NewBinding(key.WithKeys("y"),
-			key.WithHelp("y", "copy board to system clipboard"),
+			key.WithHelp("y", "copy board to clipboard"),
 		),
 	}
+	keybindings.Apply("boards", &km)
+	return km
 }
 
 func (km Keymap) ShortHelp() []key.Binding {
@@ -59,7 +83,12 @@ func (km Keymap) FullHelp() []key.Binding {
 	bindings = append(bindings, km.CreateBoard)
 	bindings = append(bindings, km.DeleteBoard)
 	bindings = append(bindings, km.RenameBoard)
+	bindings = append(bindings, km.DefaultTags)
+	bindings = append(bindings, km.MoveUp)
+	bindings = append(bindings, km.MoveDown)
 	bindings = append(bindings, km.RefreshList)
+	bindings = append(bindings, km.ShowTrash)
+	bindings = append(bindings, km.ShowActivity)
 	bindings = append(bindings, km.Copy)
 	return bindings
 }
@@ -20,6 +20,16 @@ type RenameBoardMsg struct {
 	Error error
 }
 
+type SetDefaultTagsMsg struct {
+	Board *service.Board
+	Error error
+}
+
+type MoveBoardMsg struct {
+	Board *service.Board
+	Error error
+}
+
 type DeleteBoardMsg struct {
 	Board *service.Board
 	Error error
@@ -8,16 +8,21 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
+	"github.com/rhajizada/donezo/internal/tui/itemlist"
+	"github.com/rhajizada/donezo/internal/tui/tagcomplete"
 )
 
 //nolint:recvcheck // Bubble Tea models intentionally mix value/pointer receivers for tea.Model interface.
 type MenuModel struct {
-	ctx    context.Context
-	List   list.Model
-	Input  textinput.Model
-	Keys   *Keymap
-	State  InputState
-	Client *service.Service
+	ctx       context.Context
+	List      list.Model
+	Input     textinput.Model
+	Keys      *Keymap
+	State     InputState
+	Completer tagcomplete.Model
+	Clipboard clipboard.Clipboard
+	Client    *service.Service
 }
 
 func (m MenuModel) Init() tea.Cmd {
@@ -33,15 +38,17 @@ func New(ctx context.Context, client *service.Service) MenuModel {
 	)
 	input := textinput.New()
 	keymap := NewKeymap()
+	list.KeyMap = itemlist.DefaultKeyMap().List()
 	list.Title = "donezo | Boards"
 	list.AdditionalShortHelpKeys = keymap.ShortHelp
 	list.AdditionalFullHelpKeys = keymap.FullHelp
 	return MenuModel{
-		ctx:    ctx,
-		List:   list,
-		Input:  input,
-		Keys:   &keymap,
-		State:  DefaultState,
-		Client: client,
+		ctx:       ctx,
+		List:      list,
+		Input:     input,
+		Keys:      &keymap,
+		State:     DefaultState,
+		Clipboard: clipboard.Current(),
+		Client:    client,
 	}
 }
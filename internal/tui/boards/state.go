@@ -6,4 +6,5 @@ const (
 	DefaultState InputState = iota
 	CreateBoardState
 	RenameBoardState
+	DefaultTagsState
 )
@@ -3,19 +3,17 @@ package boards
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	tea "charm.land/bubbletea/v2"
-	"golang.design/x/clipboard"
 
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/helpers"
 	"github.com/rhajizada/donezo/internal/tui/styles"
+	"github.com/rhajizada/donezo/internal/tui/tagcomplete"
 )
 
-//nolint:gochecknoglobals // injectable for tests
-var writeClipboardText = func(data []byte) {
-	clipboard.Write(clipboard.FmtText, data)
-}
-
 func (m *MenuModel) selectedItem() (Item, bool) {
 	item, ok := m.List.SelectedItem().(Item)
 	return item, ok
@@ -34,7 +32,7 @@ func (m *MenuModel) ListBoards() tea.Cmd {
 	}
 }
 
-// Copy copies board name to system clipboard.
+// Copy copies the items of the selected board as markdown to the clipboard.
 func (m *MenuModel) Copy() tea.Cmd {
 	selected, ok := m.selectedItem()
 	if !ok {
@@ -48,12 +46,15 @@ func (m *MenuModel) Copy() tea.Cmd {
 		}
 	}
 	md := service.ItemsToMarkdown(currentBoard.Name, *items)
-	writeClipboardText([]byte(md))
-	return m.List.NewStatusMessage(
+	copyCmd, err := m.Clipboard.Write([]byte(md))
+	if err != nil {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render(err.Error()))
+	}
+	return tea.Batch(copyCmd, m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
-			fmt.Sprintf("copied \"%s\" to system clipboard", currentBoard.Name),
+			fmt.Sprintf("copied \"%s\" to clipboard", currentBoard.Name),
 		),
-	)
+	))
 }
 
 // CreateBoard creates a new board.
@@ -83,6 +84,49 @@ func (m *MenuModel) RenameBoard() tea.Cmd {
 	}
 }
 
+// SetDefaultTags saves the default tags entered for the selected board.
+func (m *MenuModel) SetDefaultTags() tea.Cmd {
+	value := m.Input.Value()
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return SetDefaultTagsMsg{Error: errors.New("no board selected")}
+		}
+		tags, err := helpers.ExtractTags(value)
+		if err != nil {
+			return SetDefaultTagsMsg{Error: err}
+		}
+		board, err := m.Client.SetBoardDefaultTags(m.ctx, &selected.Board, tags)
+		return SetDefaultTagsMsg{Board: board, Error: err}
+	}
+}
+
+// InitDefaultTags sets list state to DefaultTagsState to edit the default
+// tags of the selected board, with completion of existing tags.
+func (m *MenuModel) InitDefaultTags() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no board selected"))
+	}
+	m.State = DefaultTagsState
+	m.Input.Placeholder = "Enter comma-separated list of default tags"
+	m.Input.SetValue(strings.Join(selected.Board.DefaultTags, helpers.TagsSeparator+" "))
+	m.Input.CursorEnd()
+	m.Input.Focus()
+
+	completer, err := tagcomplete.Load(m.ctx, m.Client)
+	if err != nil {
+		// Editing still works without suggestions.
+		m.Completer = tagcomplete.New(nil)
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(fmt.Sprintf("failed loading tags: %v", err)),
+		)
+	}
+	m.Completer = completer
+	m.Completer.SetValue(m.Input.Value())
+	return nil
+}
+
 // InitCreateBoard sets list state to CreateBoardState to render text input.
 func (m *MenuModel) InitCreateBoard() tea.Cmd {
 	m.State = CreateBoardState
@@ -104,6 +148,32 @@ func (m *MenuModel) DeleteBoard() tea.Cmd {
 	}
 }
 
+// MoveBoard moves selected board by delta places in place and saves the new order.
+func (m *MenuModel) MoveBoard(delta int) tea.Cmd {
+	if m.List.IsFiltered() {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("clear the filter to reorder boards"))
+	}
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no board selected"))
+	}
+	index := m.List.Index()
+	target := index + delta
+	if target < 0 || target >= len(m.List.Items()) {
+		return nil
+	}
+
+	items := slices.Clone(m.List.Items())
+	items[index], items[target] = items[target], items[index]
+	m.List.SetItems(items)
+	m.List.Select(target)
+
+	return func() tea.Msg {
+		err := m.Client.MoveBoardTo(m.ctx, &selected.Board, target)
+		return MoveBoardMsg{Board: &selected.Board, Error: err}
+	}
+}
+
 // InitRenameBoard sets list state to InitRenameBoard to render text input.
 func (m *MenuModel) InitRenameBoard() tea.Cmd {
 	m.State = RenameBoardState
@@ -153,6 +223,14 @@ func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case RenameBoardMsg:
 		cmd := m.HandleRenameBoard(msg)
 		cmds = append(cmds, cmd)
+
+	case SetDefaultTagsMsg:
+		cmd := m.HandleSetDefaultTags(msg)
+		cmds = append(cmds, cmd)
+
+	case MoveBoardMsg:
+		cmd := m.HandleMoveBoard(msg)
+		cmds = append(cmds, cmd)
 	}
 
 	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && keyMsg.Code == tea.KeyEsc {
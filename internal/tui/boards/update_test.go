@@ -9,6 +9,7 @@ import (
 
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
 )
 
 func TestCopyBoardWritesMarkdown(t *testing.T) {
@@ -27,9 +28,9 @@ func TestCopyBoardWritesMarkdown(t *testing.T) {
 			board, err := svc.CreateBoard(ctx, "Inbox")
 			require.NoError(t, err)
 
-			_, err = svc.CreateItem(ctx, board, "task", "desc")
+			_, err = svc.CreateItem(ctx, board, "task", "desc", nil)
 			require.NoError(t, err)
-			item2, err := svc.CreateItem(ctx, board, "done", "complete me")
+			item2, err := svc.CreateItem(ctx, board, "done", "complete me", nil)
 			require.NoError(t, err)
 			item2.Completed = true
 			_, err = svc.UpdateItem(ctx, item2)
@@ -44,16 +45,15 @@ func TestCopyBoardWritesMarkdown(t *testing.T) {
 
 			expected := service.ItemsToMarkdown(board.Name, *items)
 
-			var captured []byte
-			prevWrite := writeClipboardText
-			writeClipboardText = func(data []byte) { captured = append([]byte{}, data...) }
-			defer func() { writeClipboardText = prevWrite }()
+			menu.Clipboard = clipboard.NewRegister("")
 
 			cmd := menu.Copy()
 			if cmd != nil {
 				cmd()
 			}
 
+			captured, err := menu.Clipboard.Read()
+			require.NoError(t, err)
 			assert.Equal(t, expected, string(captured))
 		})
 	}
@@ -121,3 +121,132 @@ func TestBoardCreateRenameDeleteFlow(t *testing.T) {
 		})
 	}
 }
+
+func TestMoveBoardReordersInPlace(t *testing.T) {
+	tests := []struct {
+		name      string
+		selected  int
+		delta     int
+		wantOrder []string
+		wantIndex int
+	}{
+		{name: "move down", selected: 0, delta: 1, wantOrder: []string{"two", "one", "three"}, wantIndex: 1},
+		{name: "move up", selected: 2, delta: -1, wantOrder: []string{"one", "three", "two"}, wantIndex: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			for _, name := range []string{"one", "two", "three"} {
+				_, err := svc.CreateBoard(ctx, name)
+				require.NoError(t, err)
+			}
+			boards, err := svc.ListBoards(ctx)
+			require.NoError(t, err)
+
+			menu := New(ctx, svc)
+			menu.List.SetItems(NewList(boards))
+			menu.List.Select(tt.selected)
+
+			cmd := menu.MoveBoard(tt.delta)
+			require.NotNil(t, cmd)
+			moved, ok := cmd().(MoveBoardMsg)
+			require.True(t, ok)
+			require.NoError(t, moved.Error)
+			assert.Equal(t, tt.wantIndex, menu.List.Index())
+
+			var listed []string
+			for _, listItem := range menu.List.Items() {
+				listed = append(listed, listItem.(Item).Board.Name)
+			}
+			assert.Equal(t, tt.wantOrder, listed)
+
+			boards, err = svc.ListBoards(ctx)
+			require.NoError(t, err)
+			var saved []string
+			for _, b := range *boards {
+				saved = append(saved, b.Name)
+			}
+			assert.Equal(t, tt.wantOrder, saved)
+		})
+	}
+}
+
+func TestEditDefaultTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantTags []string
+		wantErr  bool
+	}{
+		{name: "saves normalized default tags", input: "Work, home", wantTags: []string{"home", "work"}},
+		{name: "clears default tags", input: "", wantTags: nil},
+		{name: "rejects invalid tags", input: "work!", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board, err := svc.CreateBoard(ctx, "Inbox")
+			require.NoError(t, err)
+			board, err = svc.SetBoardDefaultTags(ctx, board, []string{"inbox"})
+			require.NoError(t, err)
+
+			menu := New(ctx, svc)
+			menu.List.SetItems(NewList(&[]service.Board{*board}))
+			menu.List.Select(0)
+
+			model, _ := menu.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+			menu = model.(MenuModel)
+			require.Equal(t, DefaultTagsState, menu.State)
+			assert.Equal(t, "inbox", menu.Input.Value())
+
+			menu.Input.SetValue(tt.input)
+			model, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			menu = model.(MenuModel)
+			assert.Equal(t, DefaultState, menu.State)
+
+			var result SetDefaultTagsMsg
+			for _, msg := range collectBatch(cmd) {
+				if set, ok := msg.(SetDefaultTagsMsg); ok {
+					result = set
+				}
+			}
+			if tt.wantErr {
+				require.Error(t, result.Error)
+				return
+			}
+			require.NoError(t, result.Error)
+			model, _ = menu.Update(result)
+			menu = model.(MenuModel)
+
+			selected := menu.List.SelectedItem().(Item)
+			assert.ElementsMatch(t, tt.wantTags, selected.Board.DefaultTags)
+
+			listed, err := svc.ListBoards(ctx)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.wantTags, (*listed)[0].DefaultTags)
+		})
+	}
+}
+
+func collectBatch(cmd tea.Cmd) []tea.Msg {
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		if c != nil {
+			msgs = append(msgs, collectBatch(c)...)
+		}
+	}
+	return msgs
+}
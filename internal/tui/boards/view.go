@@ -7,8 +7,17 @@ import (
 )
 
 func (m MenuModel) View() tea.View {
-	content := styles.App.Render(m.List.View())
-	if m.State != DefaultState {
+	var content string
+	switch m.State {
+	case DefaultState:
+		content = styles.App.Render(m.List.View())
+	case DefaultTagsState:
+		input := m.Input.View()
+		if suggestions := m.Completer.View(); suggestions != "" {
+			input += "\n" + suggestions
+		}
+		content = styles.App.Render(input)
+	case CreateBoardState, RenameBoardState:
 		content = styles.App.Render(m.Input.View())
 	}
 	return tea.NewView(content)
@@ -0,0 +1,134 @@
+// Package clipboard stores the boards, tags and items copied in the TUI.
+//
+// Backends are the system clipboard, OSC 52 escape sequences, which reach
+// the clipboard of the terminal even over SSH, and a register kept in a file
+// or in memory. Select picks the first usable one unless the config names a
+// backend.
+package clipboard
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// Backend names accepted by Select.
+const (
+	Auto     = "auto"
+	System   = "system"
+	OSC52    = "osc52"
+	Register = "register"
+	None     = "none"
+)
+
+// Backends lists the names accepted by Select.
+//
+//nolint:gochecknoglobals // static list of backend names
+var Backends = []string{Auto, System, OSC52, Register, None}
+
+// Clipboard is where copied text goes and pasted text comes from.
+type Clipboard interface {
+	// Name returns the name of the backend.
+	Name() string
+	// Write copies data. Backends copying through the terminal return the
+	// command doing so, which has to reach the program for the copy to
+	// happen.
+	Write(data []byte) (tea.Cmd, error)
+	Read() ([]byte, error)
+}
+
+// current is the clipboard the TUI uses. It defaults to an in-memory
+// register so copy and paste work before Configure is called, as in tests.
+//
+//nolint:gochecknoglobals // set once at startup by Configure
+var current Clipboard = NewRegister("")
+
+// Configure sets the clipboard used by the TUI.
+func Configure(c Clipboard) {
+	current = c
+}
+
+// Current returns the clipboard used by the TUI.
+func Current() Clipboard {
+	return current
+}
+
+// Environment is what Select needs to set up the backends.
+type Environment struct {
+	// InitSystem initializes the system clipboard.
+	InitSystem func() error
+	// Terminal reports whether the TUI runs in a terminal, which OSC 52
+	// sequences are sent to.
+	Terminal bool
+	// Tmux wraps OSC 52 sequences so tmux passes them on.
+	Tmux bool
+	// RegisterPath is the file of the register, "" to keep it in memory.
+	RegisterPath string
+}
+
+// Select returns the backend called name. Auto, or an empty name, picks the
+// system clipboard if it can be initialized, otherwise OSC 52 when there is a
+// terminal, otherwise the register. A backend that cannot be used is returned
+// as a disabled clipboard along with the reason.
+func Select(name string, env Environment) (Clipboard, error) {
+	switch name {
+	case "", Auto:
+		if env.InitSystem != nil && env.InitSystem() == nil {
+			return systemClipboard{}, nil
+		}
+		if env.Terminal {
+			return NewOSC52(env.Tmux), nil
+		}
+		return NewRegister(env.RegisterPath), nil
+	case System:
+		if env.InitSystem == nil {
+			return disable(errors.New("system clipboard is not supported"))
+		}
+		if err := env.InitSystem(); err != nil {
+			return disable(fmt.Errorf("system clipboard: %w", err))
+		}
+		return systemClipboard{}, nil
+	case OSC52:
+		if !env.Terminal {
+			return disable(errors.New("osc52: output is not a terminal"))
+		}
+		return NewOSC52(env.Tmux), nil
+	case Register:
+		return NewRegister(env.RegisterPath), nil
+	case None:
+		return Disabled(errors.New("clipboard is disabled in the config")), nil
+	default:
+		return nil, fmt.Errorf("unknown clipboard %q, expected %s", name, strings.Join(Backends, ", "))
+	}
+}
+
+// ValidName reports whether name is accepted by Select.
+func ValidName(name string) bool {
+	return name == "" || slices.Contains(Backends, name)
+}
+
+func disable(err error) (Clipboard, error) {
+	return Disabled(err), err
+}
+
+// Disabled returns a clipboard that fails every copy and paste with err.
+func Disabled(err error) Clipboard {
+	return disabled{err: err}
+}
+
+type disabled struct {
+	err error
+}
+
+func (d disabled) Name() string { return None }
+
+func (d disabled) Write([]byte) (tea.Cmd, error) {
+	return nil, fmt.Errorf("clipboard unavailable: %w", d.err)
+}
+
+func (d disabled) Read() ([]byte, error) {
+	return nil, fmt.Errorf("clipboard unavailable: %w", d.err)
+}
@@ -0,0 +1,151 @@
+package clipboard_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
+)
+
+func TestSelect(t *testing.T) {
+	noDisplay := func() error { return errors.New("no display") }
+	display := func() error { return nil }
+
+	tests := []struct {
+		name    string
+		backend string
+		env     clipboard.Environment
+		want    string
+		wantErr string
+	}{
+		{name: "auto prefers the system clipboard", env: clipboard.Environment{
+			InitSystem: display, Terminal: true,
+		}, want: clipboard.System},
+		{name: "auto falls back to osc52", backend: clipboard.Auto, env: clipboard.Environment{
+			InitSystem: noDisplay, Terminal: true,
+		}, want: clipboard.OSC52},
+		{name: "auto falls back to the register", env: clipboard.Environment{
+			InitSystem: noDisplay,
+		}, want: clipboard.Register},
+		{name: "system", backend: clipboard.System, env: clipboard.Environment{
+			InitSystem: display,
+		}, want: clipboard.System},
+		{
+			name: "system without a display", backend: clipboard.System,
+			env:  clipboard.Environment{InitSystem: noDisplay},
+			want: clipboard.None, wantErr: "system clipboard: no display",
+		},
+		{
+			name: "osc52 without a terminal", backend: clipboard.OSC52,
+			want: clipboard.None, wantErr: "osc52: output is not a terminal",
+		},
+		{name: "register", backend: clipboard.Register, env: clipboard.Environment{
+			InitSystem: display, Terminal: true,
+		}, want: clipboard.Register},
+		{name: "none", backend: clipboard.None, want: clipboard.None},
+		{
+			name: "unknown", backend: "xclip",
+			wantErr: `unknown clipboard "xclip", expected auto, system, osc52, register, none`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := clipboard.Select(tt.backend, tt.env)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.want == "" {
+				assert.Nil(t, c)
+				return
+			}
+			require.NotNil(t, c)
+			assert.Equal(t, tt.want, c.Name())
+		})
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	c, err := clipboard.Select(clipboard.None, clipboard.Environment{})
+	require.NoError(t, err)
+
+	_, err = c.Write([]byte("x"))
+	require.EqualError(t, err, "clipboard unavailable: clipboard is disabled in the config")
+	_, err = c.Read()
+	require.EqualError(t, err, "clipboard unavailable: clipboard is disabled in the config")
+}
+
+func TestOSC52(t *testing.T) {
+	tests := []struct {
+		name string
+		tmux bool
+		want tea.Msg
+	}{
+		{name: "plain", want: tea.SetClipboard("hello")()},
+		{name: "tmux", tmux: true, want: tea.RawMsg{Msg: "\x1bPtmux;\x1b\x1b]52;c;aGVsbG8=\x07\x1b\\"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := clipboard.NewOSC52(tt.tmux)
+
+			_, err := c.Read()
+			require.EqualError(t, err, "nothing copied yet")
+
+			cmd, err := c.Write([]byte("hello"))
+			require.NoError(t, err)
+			require.NotNil(t, cmd, "the program writes the sequence")
+			assert.Equal(t, tt.want, cmd())
+
+			data, err := c.Read()
+			require.NoError(t, err)
+			assert.Equal(t, "hello", string(data))
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "donezo", "clipboard")
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "in memory"},
+		{name: "in a file", path: path},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := clipboard.NewRegister(tt.path)
+
+			_, err := c.Read()
+			require.EqualError(t, err, "nothing copied yet")
+
+			for _, text := range []string{"first", "second"} {
+				cmd, writeErr := c.Write([]byte(text))
+				require.NoError(t, writeErr)
+				assert.Nil(t, cmd)
+			}
+			data, err := c.Read()
+			require.NoError(t, err)
+			assert.Equal(t, "second", string(data))
+		})
+	}
+
+	// Another process reads what this one copied.
+	data, err := clipboard.NewRegister(path).Read()
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
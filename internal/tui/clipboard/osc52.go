@@ -0,0 +1,48 @@
+package clipboard
+
+import (
+	"encoding/base64"
+	"errors"
+	"sync"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// osc52 copies to the clipboard of the terminal with an OSC 52 escape
+// sequence, written by the program so it never lands in the middle of a
+// frame. Terminals rarely let programs read their clipboard, so pasting
+// returns what was last copied in this process.
+type osc52 struct {
+	tmux bool
+
+	mu   sync.Mutex
+	last []byte
+}
+
+// NewOSC52 returns a clipboard copying with OSC 52 sequences. With tmux the
+// sequences are wrapped for tmux to pass them on to the terminal it runs in.
+func NewOSC52(tmux bool) Clipboard {
+	return &osc52{tmux: tmux}
+}
+
+func (c *osc52) Name() string { return OSC52 }
+
+func (c *osc52) Write(data []byte) (tea.Cmd, error) {
+	c.mu.Lock()
+	c.last = append([]byte(nil), data...)
+	c.mu.Unlock()
+	if !c.tmux {
+		return tea.SetClipboard(string(data)), nil
+	}
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString(data) + "\x07"
+	return tea.Raw("\x1bPtmux;\x1b" + seq + "\x1b\\"), nil
+}
+
+func (c *osc52) Read() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.last == nil {
+		return nil, errors.New("nothing copied yet")
+	}
+	return c.last, nil
+}
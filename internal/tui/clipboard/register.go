@@ -0,0 +1,68 @@
+package clipboard
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// register keeps copied text in a file, shared by every donezo process, or
+// in memory when it has no path.
+type register struct {
+	path string
+
+	mu   sync.Mutex
+	data []byte
+}
+
+// NewRegister returns a clipboard stored in the file at path, or in memory
+// when path is "".
+func NewRegister(path string) Clipboard {
+	return &register{path: path}
+}
+
+func (r *register) Name() string { return Register }
+
+func (r *register) Write(data []byte) (tea.Cmd, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.path == "" {
+		r.data = append([]byte(nil), data...)
+		return nil, nil //nolint:nilnil // copied without a command
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o700); err != nil {
+		return nil, fmt.Errorf("register: %w", err)
+	}
+	// Write a temporary file first so a concurrent read never sees half of it.
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return nil, fmt.Errorf("register: %w", err)
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		return nil, fmt.Errorf("register: %w", err)
+	}
+	return nil, nil //nolint:nilnil // copied without a command
+}
+
+func (r *register) Read() ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.path == "" {
+		if r.data == nil {
+			return nil, errors.New("nothing copied yet")
+		}
+		return r.data, nil
+	}
+	data, err := os.ReadFile(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.New("nothing copied yet")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("register: %w", err)
+	}
+	return data, nil
+}
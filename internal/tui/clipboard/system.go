@@ -0,0 +1,41 @@
+package clipboard
+
+import (
+	"errors"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	sysclip "golang.design/x/clipboard"
+)
+
+// InitSystem initializes the system clipboard, which needs a display server
+// on Linux.
+func InitSystem() error {
+	err := sysclip.Init()
+	if err == nil {
+		return nil
+	}
+	// Without a display the error goes on with lines of installation advice.
+	msg, _, _ := strings.Cut(err.Error(), "\n")
+	msg, _, _ = strings.Cut(msg, ", and the clipboard package")
+	return errors.New(msg)
+}
+
+// systemClipboard is the clipboard of the desktop. InitSystem must have
+// succeeded before it is used.
+type systemClipboard struct{}
+
+func (systemClipboard) Name() string { return System }
+
+func (systemClipboard) Write(data []byte) (tea.Cmd, error) {
+	sysclip.Write(sysclip.FmtText, data)
+	return nil, nil //nolint:nilnil // copied without a command
+}
+
+func (systemClipboard) Read() ([]byte, error) {
+	data := sysclip.Read(sysclip.FmtText)
+	if data == nil {
+		return nil, errors.New("system clipboard holds no text")
+	}
+	return data, nil
+}
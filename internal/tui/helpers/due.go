@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const DueDateLayout = "2006-01-02"
+
+// DueState describes where a due date falls relative to today.
+type DueState uint8
+
+const (
+	NotDue DueState = iota
+	DueLater
+	DueToday
+	Overdue
+)
+
+// ParseDueDate parses a YYYY-MM-DD date in local time. An empty input clears the due date.
+func ParseDueDate(input string) (*time.Time, error) {
+	sanitized := strings.TrimSpace(input)
+	if sanitized == "" {
+		return nil, nil //nolint:nilnil // no due date is a valid result
+	}
+
+	due, err := time.ParseInLocation(DueDateLayout, sanitized, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("due date must be in %s format", DueDateLayout)
+	}
+	return &due, nil
+}
+
+// FormatDueDate renders a due date the same way ParseDueDate expects it.
+func FormatDueDate(due *time.Time) string {
+	if due == nil {
+		return ""
+	}
+	return due.In(time.Local).Format(DueDateLayout)
+}
+
+// DueStateAt classifies due against the calendar day of now.
+func DueStateAt(due *time.Time, now time.Time) DueState {
+	if due == nil {
+		return NotDue
+	}
+	dueDay := FormatDueDate(due)
+	today := now.In(time.Local).Format(DueDateLayout)
+	switch {
+	case dueDay < today:
+		return Overdue
+	case dueDay == today:
+		return DueToday
+	default:
+		return DueLater
+	}
+}
@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDueDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantNil bool
+		wantErr string
+	}{
+		{name: "parses date", input: "2026-03-14", want: "2026-03-14"},
+		{name: "trims spaces", input: "  2026-03-14 ", want: "2026-03-14"},
+		{name: "empty clears due date", input: "", wantNil: true},
+		{name: "error on invalid date", input: "14/03/2026", wantErr: "due date must be in 2006-01-02 format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			due, err := ParseDueDate(tt.input)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, due)
+				return
+			}
+			assert.Equal(t, tt.want, FormatDueDate(due))
+		})
+	}
+}
+
+func TestDueStateAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 14, 15, 30, 0, 0, time.Local)
+	day := func(offset int) *time.Time {
+		d := time.Date(2026, time.March, 14+offset, 0, 0, 0, 0, time.Local)
+		return &d
+	}
+
+	tests := []struct {
+		name string
+		due  *time.Time
+		want DueState
+	}{
+		{name: "no due date", due: nil, want: NotDue},
+		{name: "yesterday is overdue", due: day(-1), want: Overdue},
+		{name: "today is due today", due: day(0), want: DueToday},
+		{name: "tomorrow is due later", due: day(1), want: DueLater},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, DueStateAt(tt.due, now))
+		})
+	}
+}
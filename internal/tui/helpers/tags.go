@@ -3,10 +3,17 @@ package helpers
 import (
 	"errors"
 	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/styles"
 )
 
 const TagsSeparator = ","
 
+// ExtractTags splits a comma-separated list of tags and brings each tag into
+// its canonical form, see service.NormalizeTag.
 func ExtractTags(input string) ([]string, error) {
 	tags := strings.Split(input, TagsSeparator)
 
@@ -23,5 +30,27 @@ func ExtractTags(input string) ([]string, error) {
 		tags[idx] = sanitized
 	}
 
-	return tags, nil
+	return service.NormalizeTags(tags)
+}
+
+// RenderTags joins tags for display. Tags with metadata are prefixed with
+// their emoji and drawn as chips in their color.
+func RenderTags(tags []string, meta map[string]service.TagMetadata) string {
+	chips := make([]string, len(tags))
+	for i, tag := range tags {
+		chips[i] = RenderTag(tag, meta[tag])
+	}
+	return strings.Join(chips, TagsSeparator+" ")
+}
+
+// RenderTag renders a single tag with its metadata.
+func RenderTag(tag string, meta service.TagMetadata) string {
+	label := tag
+	if meta.Emoji != "" {
+		label = meta.Emoji + " " + label
+	}
+	if meta.Color == "" {
+		return label
+	}
+	return styles.TagChip.Background(lipgloss.Color(meta.Color)).Render(label)
 }
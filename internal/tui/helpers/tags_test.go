@@ -5,6 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
 )
 
 func TestExtractTags(t *testing.T) {
@@ -20,6 +23,12 @@ func TestExtractTags(t *testing.T) {
 		{name: "empty string yields none", input: "", want: []string{}},
 		{name: "single tag", input: "one", want: []string{"one"}},
 		{name: "error on empty tag", input: "a,", wantErr: "tag must not be empty string"},
+		{name: "normalizes and deduplicates", input: "Work, work ,Day Job", want: []string{"work", "day-job"}},
+		{
+			name:    "error on invalid tag",
+			input:   "work, urgent!",
+			wantErr: `invalid tag "urgent!": '!' is not allowed, use letters, digits, "-", "_" or "."`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -38,3 +47,39 @@ func TestExtractTags(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderTags(t *testing.T) {
+	t.Parallel()
+
+	meta := map[string]service.TagMetadata{
+		"work": {TagMetadata: repository.TagMetadata{Tag: "work", Emoji: "💼"}},
+		"home": {TagMetadata: repository.TagMetadata{Tag: "home", Color: "#04B575"}},
+	}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		want     string
+		contains []string
+	}{
+		{name: "plain tags", tags: []string{"a", "b"}, want: "a, b"},
+		{name: "emoji prefix", tags: []string{"work", "b"}, want: "💼 work, b"},
+		{name: "colored chip", tags: []string{"home"}, contains: []string{"home", "48;2;4;181;117"}},
+		{name: "no tags", tags: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := RenderTags(tt.tags, meta)
+			if tt.contains != nil {
+				for _, needle := range tt.contains {
+					assert.Contains(t, got, needle)
+				}
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
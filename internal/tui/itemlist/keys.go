@@ -1,20 +1,26 @@
 package itemlist
 
-import "charm.land/bubbles/v2/key"
+import (
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/list"
+
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+)
 
 // KeyMap defines keybindings. It satisfies to the help.KeyMap interface, which
 // is used to render the menu.
 type KeyMap struct {
 	// Keybindings used when browsing the list.
-	CursorUp    key.Binding
-	CursorDown  key.Binding
-	NextPage    key.Binding
-	PrevPage    key.Binding
-	GoToStart   key.Binding
-	GoToEnd     key.Binding
-	Filter      key.Binding
-	ClearFilter key.Binding
-	ToggleHide  key.Binding
+	CursorUp         key.Binding
+	CursorDown       key.Binding
+	NextPage         key.Binding
+	PrevPage         key.Binding
+	GoToStart        key.Binding
+	GoToEnd          key.Binding
+	Filter           key.Binding
+	ClearFilter      key.Binding
+	ToggleHide       key.Binding
+	ToggleActionable key.Binding
 
 	// Keybindings used when setting a filter.
 	CancelWhileFiltering key.Binding
@@ -33,7 +39,7 @@ type KeyMap struct {
 
 // DefaultKeyMap returns a default set of keybindings.
 func DefaultKeyMap() KeyMap {
-	return KeyMap{
+	km := KeyMap{
 		// Browsing.
 		CursorUp: key.NewBinding(
 			key.WithKeys("up", "k"),
@@ -44,11 +50,11 @@ func DefaultKeyMap() KeyMap {
 			key.WithHelp("↓/j", "down"),
 		),
 		PrevPage: key.NewBinding(
-			key.WithKeys("left", "h", "pgup", "b", "u"),
+			key.WithKeys("left", "h", "pgup", "b"),
 			key.WithHelp("←/h/pgup", "prev page"),
 		),
 		NextPage: key.NewBinding(
-			key.WithKeys("right", "l", "pgdown", "f", "d"),
+			key.WithKeys("right", "l", "pgdown", "f"),
 			key.WithHelp("→/l/pgdn", "next page"),
 		),
 		GoToStart: key.NewBinding(
@@ -71,6 +77,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("z"),
 			key.WithHelp("z", "hide/show completed"),
 		),
+		ToggleActionable: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "actionable only"),
+		),
 
 		// Filtering.
 		CancelWhileFiltering: key.NewBinding(
@@ -99,4 +109,28 @@ func DefaultKeyMap() KeyMap {
 		),
 		ForceQuit: key.NewBinding(key.WithKeys("ctrl+c")),
 	}
+	keybindings.Apply("list", &km)
+	return km
+}
+
+// List returns the browsing, filtering, help and quit keys of km as the keymap
+// of a bubbles list, so the views listing boards, tags, subtasks, the trash
+// and the activity feed navigate like the item views.
+func (km KeyMap) List() list.KeyMap {
+	return list.KeyMap{
+		CursorUp:             km.CursorUp,
+		CursorDown:           km.CursorDown,
+		NextPage:             km.NextPage,
+		PrevPage:             km.PrevPage,
+		GoToStart:            km.GoToStart,
+		GoToEnd:              km.GoToEnd,
+		Filter:               km.Filter,
+		ClearFilter:          km.ClearFilter,
+		CancelWhileFiltering: km.CancelWhileFiltering,
+		AcceptWhileFiltering: km.AcceptWhileFiltering,
+		ShowFullHelp:         km.ShowFullHelp,
+		CloseFullHelp:        km.CloseFullHelp,
+		Quit:                 km.Quit,
+		ForceQuit:            km.ForceQuit,
+	}
 }
@@ -33,6 +33,13 @@ type Item interface {
 	HideValue() bool
 }
 
+// Blockable is implemented by items that can wait on other items. Items that
+// do not implement it are never blocked.
+type Blockable interface {
+	// Blocked reports whether the item waits on other items.
+	Blocked() bool
+}
+
 // ItemDelegate encapsulates the general functionality for all list items. The
 // benefit to separating this logic from the item itself is that you can change
 // the functionality of items without changing the actual items themselves.
@@ -150,6 +157,7 @@ type Model struct {
 	showHelp         bool
 	filteringEnabled bool
 	hideItems        bool
+	actionableOnly   bool
 
 	itemNameSingular string
 	itemNamePlural   string
@@ -467,7 +475,8 @@ func (m *Model) SetDelegate(d ItemDelegate) {
 }
 
 // VisibleItems returns the items available to be shown, applying
-// the text‐filter first (if any) and then the HideValue flag.
+// the text‐filter first (if any) and then the HideValue flag. When only
+// actionable items are shown, hidden and blocked items are left out too.
 func (m Model) VisibleItems() []Item {
 	var base []Item
 	if m.filterState != Unfiltered {
@@ -476,15 +485,19 @@ func (m Model) VisibleItems() []Item {
 		base = m.items
 	}
 
-	if !m.hideItems {
+	if !m.hideItems && !m.actionableOnly {
 		return base
 	}
 
 	var out []Item
 	for _, it := range base {
-		if !it.HideValue() {
-			out = append(out, it)
+		if it.HideValue() {
+			continue
 		}
+		if b, ok := it.(Blockable); ok && m.actionableOnly && b.Blocked() {
+			continue
+		}
+		out = append(out, it)
 	}
 	return out
 }
@@ -642,6 +655,31 @@ func (m *Model) ToggleHide() {
 	m.cursor = 0
 }
 
+// HideCompleted reports whether completed items are hidden.
+func (m Model) HideCompleted() bool {
+	return m.hideItems
+}
+
+// SetHideCompleted hides or shows completed items.
+func (m *Model) SetHideCompleted(hide bool) {
+	if m.hideItems != hide {
+		m.ToggleHide()
+	}
+}
+
+// ToggleActionable flips the “actionable only” state, which hides completed
+// and blocked items.
+func (m *Model) ToggleActionable() {
+	m.actionableOnly = !m.actionableOnly
+	m.updatePagination()
+	m.cursor = 0
+}
+
+// ActionableOnly reports whether only actionable items are shown.
+func (m Model) ActionableOnly() bool {
+	return m.actionableOnly
+}
+
 // IsFiltered returns whether or not the list is currently filtered.
 // It's purely a convenience method for the following:
 //
@@ -820,6 +858,7 @@ func (m Model) FullHelp() [][]key.Binding {
 	listLevelBindings := []key.Binding{
 		m.KeyMap.Filter,
 		m.KeyMap.ToggleHide,
+		m.KeyMap.ToggleActionable,
 		m.KeyMap.ClearFilter,
 		m.KeyMap.AcceptWhileFiltering,
 		m.KeyMap.CancelWhileFiltering,
@@ -945,6 +984,8 @@ func (m *Model) handleBrowsing(msg tea.Msg) tea.Cmd {
 			return textinput.Blink
 		case key.Matches(keyMsg, m.KeyMap.ToggleHide):
 			m.ToggleHide()
+		case key.Matches(keyMsg, m.KeyMap.ToggleActionable):
+			m.ToggleActionable()
 		case key.Matches(keyMsg, m.KeyMap.ShowFullHelp):
 			fallthrough
 		case key.Matches(keyMsg, m.KeyMap.CloseFullHelp):
@@ -9,12 +9,14 @@ import (
 )
 
 type stubItem struct {
-	title  string
-	hidden bool
+	title   string
+	hidden  bool
+	blocked bool
 }
 
 func (s stubItem) FilterValue() string { return s.title }
 func (s stubItem) HideValue() bool     { return s.hidden }
+func (s stubItem) Blocked() bool       { return s.blocked }
 
 type stubDelegate struct {
 	height  int
@@ -115,3 +117,22 @@ func TestFilteringFlowEnablesBindings(t *testing.T) {
 		})
 	}
 }
+
+func TestToggleActionableHidesBlockedAndCompletedItems(t *testing.T) {
+	m := newModel([]Item{
+		stubItem{title: "ready"},
+		stubItem{title: "done", hidden: true},
+		stubItem{title: "waiting", blocked: true},
+	})
+	assert.Len(t, m.VisibleItems(), 3)
+
+	m.ToggleActionable()
+	assert.True(t, m.ActionableOnly())
+	visible := m.VisibleItems()
+	assert.Len(t, visible, 1)
+	assert.Equal(t, "ready", visible[0].(stubItem).title)
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'x', Text: "x"})
+	assert.False(t, m.ActionableOnly())
+	assert.Len(t, m.VisibleItems(), 3)
+}
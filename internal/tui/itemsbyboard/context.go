@@ -1,20 +1,33 @@
 package itemsbyboard
 
+import "github.com/rhajizada/donezo/internal/service"
+
 type InputState uint8
 
 const (
 	DefaultState InputState = iota
 	CreateItemNameState
 	CreateItemDescState
+	CreateItemBoardState
 	RenameItemNameState
 	RenameItemDescState
 	UpdateTagsState
+	UpdateDueState
+	UpdateRecurrenceState
+	MoveItemState
+	HistoryState
+	BlockersState
 )
 
 type InputContext struct {
 	State InputState
 	Title string
 	Desc  string
+	// Quick is the parsed quick-add line of the item being created.
+	Quick service.QuickAdd
+	// Board is the board the item is created in, nil when the board named
+	// by Quick does not exist yet and is created along with the item.
+	Board *service.Board
 }
 
 func NewInputContext() *InputContext {
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/helpers"
 	"github.com/rhajizada/donezo/internal/tui/styles"
 
 	"charm.land/lipgloss/v2"
@@ -13,7 +16,8 @@ import (
 )
 
 // ListDelegate is a fully custom delegate that replicates the default behavior
-// but adds a strikethrough to completed items and applies padding.
+// but adds a strikethrough to completed items, priority and blocked markers, due
+// date highlights and applies padding.
 type ListDelegate struct {
 	*itemlist.DefaultDelegate // Embed as a pointer to avoid invalid indirection
 }
@@ -35,6 +39,12 @@ func (d *ListDelegate) Render(w io.Writer, m itemlist.Model, index int, item ite
 	}
 
 	title := selected.Itm.Title
+	if marker := service.Priority(selected.Itm.Priority).Marker(); marker != "" {
+		title = fmt.Sprintf("%s %s", marker, title)
+	}
+	if selected.Itm.BlockedBy > 0 && !selected.Itm.Completed {
+		title = fmt.Sprintf("%s [blocked by %d]", title, selected.Itm.BlockedBy)
+	}
 	desc := selected.Itm.Description
 	completed := selected.Itm.Completed
 
@@ -73,6 +83,18 @@ func (d *ListDelegate) Render(w io.Writer, m itemlist.Model, index int, item ite
 		descStyle = d.Styles.NormalDesc.Strikethrough(completed)
 	}
 
+	// Highlight open items that are due today or overdue
+	if !completed {
+		switch helpers.DueStateAt(selected.Itm.DueAt, time.Now()) {
+		case helpers.Overdue:
+			titleStyle = titleStyle.Foreground(styles.Overdue.GetForeground())
+		case helpers.DueToday:
+			titleStyle = titleStyle.Foreground(styles.DueToday.GetForeground())
+		case helpers.NotDue, helpers.DueLater:
+			// keep default colors
+		}
+	}
+
 	styledTitle := titleStyle.Render(title)
 	styledDesc := descStyle.Render(desc)
 
@@ -3,6 +3,12 @@ package itemsbyboard
 import (
 	"fmt"
 
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/activity"
+	"github.com/rhajizada/donezo/internal/tui/blockerpicker"
+	"github.com/rhajizada/donezo/internal/tui/boardpicker"
+	"github.com/rhajizada/donezo/internal/tui/boards"
+	"github.com/rhajizada/donezo/internal/tui/helpers"
 	"github.com/rhajizada/donezo/internal/tui/styles"
 
 	"charm.land/bubbles/v2/key"
@@ -37,7 +43,17 @@ func (m *MenuModel) HandleCreateItem(msg CreateItemMsg) tea.Cmd {
 			),
 		)
 	}
-	m.List.InsertItem(len(m.List.Items()), NewItem(msg.Item))
+	if msg.NewBoard && m.Parent != nil {
+		m.Parent.List.InsertItem(len(m.Parent.List.Items()), boards.NewItem(msg.Board))
+	}
+	if current, ok := m.selectedBoard(); ok && msg.Item.BoardID != current.Board.ID && msg.Board != nil {
+		return m.List.NewStatusMessage(
+			styles.StatusMessage.Render(
+				fmt.Sprintf("created item \"%s\" in \"%s\"", msg.Item.Title, msg.Board.Name),
+			),
+		)
+	}
+	m.List.InsertItem(len(m.List.Items()), NewItem(msg.Item, m.TagMeta))
 	return m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
 			fmt.Sprintf("created item \"%s\"", msg.Item.Title),
@@ -57,7 +73,7 @@ func (m *MenuModel) HandleDeleteItem(msg DeleteItemMsg) tea.Cmd {
 
 	return m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
-			fmt.Sprintf("deleted item \"%s\"", msg.Item.Title),
+			fmt.Sprintf("moved item \"%s\" to trash", msg.Item.Title),
 		),
 	)
 }
@@ -71,7 +87,7 @@ func (m *MenuModel) HandleRenameItem(msg RenameItemMsg) tea.Cmd {
 		)
 	}
 
-	m.List.SetItem(m.List.Index(), NewItem(msg.Item))
+	m.List.SetItem(m.List.Index(), NewItem(msg.Item, m.TagMeta))
 	return m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
 			fmt.Sprintf("updated item \"%s\"", msg.Item.Title),
@@ -88,7 +104,7 @@ func (m *MenuModel) HandleUpdateTags(msg UpdateTagsMsg) tea.Cmd {
 		)
 	}
 
-	m.List.SetItem(m.List.Index(), NewItem(msg.Item))
+	m.List.SetItem(m.List.Index(), NewItem(msg.Item, m.TagMeta))
 	return m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
 			fmt.Sprintf("updated item \"%s\" tags", msg.Item.Title),
@@ -96,6 +112,57 @@ func (m *MenuModel) HandleUpdateTags(msg UpdateTagsMsg) tea.Cmd {
 	)
 }
 
+func (m *MenuModel) HandleUpdateDue(msg UpdateDueMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed updating due date: %v", msg.Error),
+			),
+		)
+	}
+
+	m.List.SetItem(m.List.Index(), NewItem(msg.Item, m.TagMeta))
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("updated item \"%s\" due date", msg.Item.Title),
+		),
+	)
+}
+
+func (m *MenuModel) HandleUpdateRecurrence(msg UpdateRecurrenceMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed updating recurrence: %v", msg.Error),
+			),
+		)
+	}
+
+	m.List.SetItem(m.List.Index(), NewItem(msg.Item, m.TagMeta))
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("updated item \"%s\" recurrence", msg.Item.Title),
+		),
+	)
+}
+
+func (m *MenuModel) HandleUpdatePriority(msg UpdatePriorityMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed updating priority: %v", msg.Error),
+			),
+		)
+	}
+
+	m.List.SetItem(m.List.Index(), NewItem(msg.Item, m.TagMeta))
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("set item \"%s\" priority to %s", msg.Item.Title, service.Priority(msg.Item.Priority)),
+		),
+	)
+}
+
 func (m *MenuModel) HandleToggleItem(msg ToggleItemMsg) tea.Cmd {
 	if msg.Error != nil {
 		return m.List.NewStatusMessage(
@@ -105,7 +172,7 @@ func (m *MenuModel) HandleToggleItem(msg ToggleItemMsg) tea.Cmd {
 		)
 	}
 
-	m.List.SetItem(m.List.Index(), NewItem(msg.Item))
+	m.List.SetItem(m.List.Index(), NewItem(msg.Item, m.TagMeta))
 	selected, ok := m.selectedItem()
 	if !ok {
 		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
@@ -116,11 +183,128 @@ func (m *MenuModel) HandleToggleItem(msg ToggleItemMsg) tea.Cmd {
 	}
 	mark := fmt.Sprintf("%scomplete", prefix)
 
-	return m.List.NewStatusMessage(
+	if msg.Next != nil {
+		// The next occurrence is a new item, reload so it shows up in place.
+		return tea.Batch(
+			m.List.NewStatusMessage(
+				styles.StatusMessage.Render(
+					fmt.Sprintf("marked item \"%s\" as %s, next due %s",
+						msg.Item.Title, mark, helpers.FormatDueDate(msg.Next.DueAt)),
+				),
+			),
+			m.ListItems(),
+		)
+	}
+
+	status := m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
 			fmt.Sprintf("marked item \"%s\" as %s", msg.Item.Title, mark),
 		),
 	)
+	if msg.Item.Dependents > 0 {
+		// Items waiting on this one may have been unblocked or blocked again.
+		return tea.Batch(status, m.ListItems())
+	}
+	return status
+}
+
+func (m *MenuModel) HandleReorderItem(msg ReorderItemMsg) tea.Cmd {
+	if msg.Error != nil {
+		// The list was reordered optimistically, reload it to match the database.
+		return tea.Batch(
+			m.List.NewStatusMessage(
+				styles.ErrorMessage.Render(
+					fmt.Sprintf("failed moving item: %v", msg.Error),
+				),
+			),
+			m.ListItems(),
+		)
+	}
+	return nil
+}
+
+func (m *MenuModel) HandleMoveItem(msg MoveItemMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed moving item: %v", msg.Error),
+			),
+		)
+	}
+
+	if idx := m.indexOf(msg.Item.ID); idx >= 0 {
+		m.List.RemoveItem(idx)
+	}
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("moved item \"%s\" to \"%s\"", msg.Item.Title, msg.Board.Name),
+		),
+	)
+}
+
+// HandlePickerState routes messages to the board picker while it is open.
+func (m *MenuModel) HandlePickerState(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.HandleWindowSize(msg)
+		m.Picker.SetSize(m.List.Width(), m.List.Height())
+		return nil
+	case boardpicker.PickedMsg:
+		m.Context.State = DefaultState
+		return m.MoveItem(msg.Board)
+	case boardpicker.CancelledMsg:
+		m.Context.State = DefaultState
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.Picker, cmd = m.Picker.Update(msg)
+	return cmd
+}
+
+// HandleBlockersState routes messages to the blocker picker while it is open.
+func (m *MenuModel) HandleBlockersState(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.HandleWindowSize(msg)
+		m.Blockers.SetSize(m.List.Width(), m.List.Height())
+		return nil
+	case blockerpicker.ToggledMsg:
+		return m.UpdateBlocker(msg.Blocker, msg.Blocking)
+	case UpdateBlockerMsg:
+		if msg.Error != nil {
+			return m.Blockers.List.NewStatusMessage(
+				styles.ErrorMessage.Render(fmt.Sprintf("failed updating blockers: %v", msg.Error)),
+			)
+		}
+		m.Blockers.SetBlocking(msg.Blocker.ID, msg.Blocking)
+		return nil
+	case blockerpicker.ClosedMsg:
+		// Reload so the blocked markers reflect the new dependencies.
+		m.Context.State = DefaultState
+		return m.ListItems()
+	}
+
+	var cmd tea.Cmd
+	m.Blockers, cmd = m.Blockers.Update(msg)
+	return cmd
+}
+
+// HandleHistoryState routes messages to the history panel while it is open.
+func (m *MenuModel) HandleHistoryState(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.HandleWindowSize(msg)
+		m.History.SetSize(m.List.Width(), m.List.Height())
+		return nil
+	case activity.ClosedMsg:
+		m.Context.State = DefaultState
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.History, cmd = m.History.Update(msg)
+	return cmd
 }
 
 // HandleInputState handles CreateItemState and RenameItemState states.
@@ -128,8 +312,22 @@ func (m *MenuModel) HandleInputState(msg tea.Msg) (textinput.Model, []tea.Cmd) {
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && m.Context.State == UpdateTagsState {
+		var value string
+		var completed bool
+		m.Completer, value, completed = m.Completer.Update(keyMsg, m.Input.Value())
+		if completed {
+			m.Input.SetValue(value)
+			m.Input.CursorEnd()
+			return m.Input, nil
+		}
+	}
+
 	m.Input, cmd = m.Input.Update(msg)
 	cmds = append(cmds, cmd)
+	if m.Context.State == UpdateTagsState {
+		m.Completer.SetValue(m.Input.Value())
+	}
 
 	// Only handle key messages in input states
 	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
@@ -137,16 +335,23 @@ func (m *MenuModel) HandleInputState(msg tea.Msg) (textinput.Model, []tea.Cmd) {
 		case tea.KeyEnter:
 			switch m.Context.State {
 			case CreateItemNameState:
-				m.Context.Title = m.Input.Value()
+				q, err := service.ParseQuickAdd(m.Input.Value())
+				if err != nil {
+					// The preview below the input already shows the error.
+					break
+				}
+				m.Context.Quick = q
+				if q.Description != "" {
+					cmds = append(cmds, m.SubmitCreateItem())
+					break
+				}
 				m.Context.State = CreateItemDescState
 				m.Input.Placeholder = "Enter item description"
 				m.Input.SetValue("")
 				m.Input.Focus()
 			case CreateItemDescState:
-				m.Context.Desc = m.Input.Value()
-				m.Context.State = DefaultState
-				m.Input.Blur()
-				cmds = append(cmds, m.CreateItem())
+				m.Context.Quick.Description = m.Input.Value()
+				cmds = append(cmds, m.SubmitCreateItem())
 			case RenameItemNameState:
 				m.Context.Title = m.Input.Value()
 				m.Context.State = RenameItemDescState
@@ -168,6 +373,16 @@ func (m *MenuModel) HandleInputState(msg tea.Msg) (textinput.Model, []tea.Cmd) {
 				m.Context.State = DefaultState
 				m.Input.Blur()
 				cmds = append(cmds, m.UpdateTags())
+			case UpdateDueState:
+				m.Context.Title = m.Input.Value()
+				m.Context.State = DefaultState
+				m.Input.Blur()
+				cmds = append(cmds, m.UpdateDue())
+			case UpdateRecurrenceState:
+				m.Context.Title = m.Input.Value()
+				m.Context.State = DefaultState
+				m.Input.Blur()
+				cmds = append(cmds, m.UpdateRecurrence())
 			case DefaultState:
 				// no-op
 			default:
@@ -178,13 +393,34 @@ func (m *MenuModel) HandleInputState(msg tea.Msg) (textinput.Model, []tea.Cmd) {
 			m.Context.State = DefaultState
 			m.Input.Blur()
 		default:
-			// ignore other key types
+			if m.Context.State == CreateItemBoardState {
+				cmds = append(cmds, m.HandleCreateBoardPrompt(keyMsg))
+			}
 		}
 	}
 
 	return m.Input, cmds
 }
 
+// HandleCreateBoardPrompt answers whether to create the missing board of a
+// quick-add line: "y" creates the board and the item, "n" discards both.
+func (m *MenuModel) HandleCreateBoardPrompt(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "y", "Y":
+		m.Context.State = DefaultState
+		return m.CreateItem()
+	case "n", "N":
+		m.Context.State = DefaultState
+		return m.List.NewStatusMessage(
+			styles.StatusMessage.Render(
+				fmt.Sprintf("discarded item \"%s\"", m.Context.Quick.Title),
+			),
+		)
+	default:
+		return nil
+	}
+}
+
 // HandleKeyInput processes key inputs not handles by list.Model.
 func (m *MenuModel) HandleKeyInput(msg tea.KeyPressMsg) tea.Cmd {
 	var cmd tea.Cmd
@@ -200,10 +436,30 @@ func (m *MenuModel) HandleKeyInput(msg tea.KeyPressMsg) tea.Cmd {
 		cmd = m.InitRenameItem()
 	case key.Matches(msg, m.Keys.UpdateTags):
 		cmd = m.InitUpdateTags()
+	case key.Matches(msg, m.Keys.UpdateDue):
+		cmd = m.InitUpdateDue()
+	case key.Matches(msg, m.Keys.UpdateRepeat):
+		cmd = m.InitUpdateRecurrence()
+	case key.Matches(msg, m.Keys.CyclePriority):
+		cmd = m.CyclePriority()
+	case key.Matches(msg, m.Keys.SortItems):
+		cmd = m.SortItems()
 	case key.Matches(msg, m.Keys.ToggleComplete):
 		cmd = m.ToggleComplete()
+	case key.Matches(msg, m.Keys.MoveToBoard):
+		cmd = m.InitMoveItem()
+	case key.Matches(msg, m.Keys.ShowHistory):
+		cmd = m.InitHistory()
+	case key.Matches(msg, m.Keys.EditBlockers):
+		cmd = m.InitBlockers()
+	case key.Matches(msg, m.Keys.MoveUp):
+		cmd = m.ReorderItem(-1)
+	case key.Matches(msg, m.Keys.MoveDown):
+		cmd = m.ReorderItem(1)
 	case key.Matches(msg, m.Keys.RefreshList):
 		cmd = m.ListItems()
+	case key.Matches(msg, m.Keys.EditChecklist):
+		cmd = m.EditChecklist()
 	case key.Matches(msg, m.Keys.Copy):
 		cmd = m.Copy()
 	case key.Matches(msg, m.Keys.Paste):
@@ -12,6 +12,7 @@ import (
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/testutil"
 	"github.com/rhajizada/donezo/internal/tui/boards"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
 	"github.com/rhajizada/donezo/internal/tui/navigation"
 )
 
@@ -21,7 +22,7 @@ func newItemMenu(t *testing.T) (MenuModel, func()) {
 	ctx := testutil.MustContext()
 	board, err := svc.CreateBoard(ctx, "Inbox")
 	require.NoError(t, err)
-	item, err := svc.CreateItem(ctx, board, "task", "desc")
+	item, err := svc.CreateItem(ctx, board, "task", "desc", nil)
 	require.NoError(t, err)
 
 	parent := boards.New(ctx, svc)
@@ -29,7 +30,7 @@ func newItemMenu(t *testing.T) (MenuModel, func()) {
 	parent.List.Select(0)
 
 	menu := New(ctx, svc, &parent)
-	menu.List.SetItems(NewList(&[]service.Item{*item}))
+	menu.List.SetItems(NewList(&[]service.Item{*item}, nil))
 	menu.List.Select(0)
 	return menu, cleanup
 }
@@ -70,6 +71,46 @@ func TestItemsByBoardKeyBindings(t *testing.T) {
 				assert.Equal(t, UpdateTagsState, menu.Context.State)
 			},
 		},
+		{
+			name: "due enters update due state",
+			msg:  tea.KeyPressMsg{Code: 'D', Text: "D"},
+			assertModel: func(t *testing.T, menu MenuModel) {
+				assert.Equal(t, UpdateDueState, menu.Context.State)
+			},
+		},
+		{
+			name: "recurrence enters update recurrence state",
+			msg:  tea.KeyPressMsg{Code: 'e', Text: "e"},
+			assertModel: func(t *testing.T, menu MenuModel) {
+				assert.Equal(t, UpdateRecurrenceState, menu.Context.State)
+			},
+		},
+		{
+			name: "sort switches to next order",
+			msg:  tea.KeyPressMsg{Code: 's', Text: "s"},
+			assertModel: func(t *testing.T, menu MenuModel) {
+				assert.Equal(t, service.OrderByCreated, menu.Order)
+			},
+		},
+		{
+			name: "priority key sends update priority message",
+			msg:  tea.KeyPressMsg{Code: 'P', Text: "P"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				msg, ok := cmd().(UpdatePriorityMsg)
+				require.True(t, ok)
+				require.NoError(t, msg.Error)
+				assert.Equal(t, int64(service.PriorityLow), msg.Item.Priority)
+			},
+		},
+		{
+			name: "checklist key opens subtasks",
+			msg:  tea.KeyPressMsg{Code: 'c', Text: "c"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				assert.Equal(t, navigation.OpenSubtasksMsg{}, cmd())
+			},
+		},
 		{
 			name: "delete sends delete message",
 			msg:  tea.KeyPressMsg{Code: 'd', Text: "d"},
@@ -139,14 +180,13 @@ func TestItemsByBoardKeyBindings(t *testing.T) {
 		menu, cleanup := newItemMenu(t)
 		defer cleanup()
 
-		var captured []byte
-		prevWrite := writeClipboardText
-		writeClipboardText = func(data []byte) { captured = append([]byte{}, data...) }
-		defer func() { writeClipboardText = prevWrite }()
+		menu.Clipboard = clipboard.NewRegister("")
 
 		_, cmd := menu.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
 		require.NotNil(t, cmd)
 		cmd()
+		captured, err := menu.Clipboard.Read()
+		require.NoError(t, err)
 		assert.NotEmpty(t, captured)
 
 		clipItem := service.Item{
@@ -157,9 +197,8 @@ func TestItemsByBoardKeyBindings(t *testing.T) {
 		}
 		data, err := json.Marshal(clipItem)
 		require.NoError(t, err)
-		prevRead := readClipboardText
-		readClipboardText = func() []byte { return data }
-		defer func() { readClipboardText = prevRead }()
+		_, err = menu.Clipboard.Write(data)
+		require.NoError(t, err)
 
 		_, cmd = menu.Update(tea.KeyPressMsg{Code: 'p', Text: "p"})
 		require.NotNil(t, cmd)
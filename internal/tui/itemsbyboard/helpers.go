@@ -1,6 +1,10 @@
 package itemsbyboard
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
 
 // Helper functions for string manipulation.
 func truncate(s string, maxLen int, ellipsis string) string {
@@ -16,3 +20,18 @@ func truncate(s string, maxLen int, ellipsis string) string {
 func splitLines(s string) []string {
 	return strings.Split(s, "\n")
 }
+
+// findBoard returns the board called name, preferring an exact match over
+// one differing in case, or nil when there is none.
+func findBoard(boardList []service.Board, name string) *service.Board {
+	var folded *service.Board
+	for i := range boardList {
+		switch {
+		case boardList[i].Name == name:
+			return &boardList[i]
+		case folded == nil && strings.EqualFold(boardList[i].Name, name):
+			folded = &boardList[i]
+		}
+	}
+	return folded
+}
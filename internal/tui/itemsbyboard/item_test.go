@@ -2,9 +2,11 @@ package itemsbyboard_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/rhajizada/donezo/internal/repository"
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/tui/itemsbyboard"
 )
@@ -13,11 +15,39 @@ func TestItemsByBoardItemAccessors(t *testing.T) {
 	tests := []struct {
 		name       string
 		completed  bool
+		due        *time.Time
+		recurrence string
+		subtasks   [2]int64
+		blockedBy  int64
+		meta       map[string]service.TagMetadata
 		wantHidden bool
 		wantFooter string
 	}{
 		{name: "incomplete item stays visible", completed: false, wantHidden: false, wantFooter: "Tags: work"},
 		{name: "completed item is hidden", completed: true, wantHidden: true, wantFooter: "Tags: work"},
+		{
+			name:       "due date is shown in footer",
+			due:        new(time.Date(2026, time.March, 14, 0, 0, 0, 0, time.Local)),
+			wantFooter: "Due: 2026-03-14 | Tags: work",
+		},
+		{
+			name:       "recurrence is shown in footer",
+			recurrence: "monthly 1",
+			wantFooter: "Repeats: monthly 1 | Tags: work",
+		},
+		{
+			name:       "checklist progress is shown in footer",
+			subtasks:   [2]int64{3, 5},
+			wantFooter: "Tags: work | 3/5 done",
+		},
+		{name: "blocked item", blockedBy: 2, wantFooter: "Tags: work"},
+		{
+			name: "tag metadata is rendered in footer",
+			meta: map[string]service.TagMetadata{
+				"work": {TagMetadata: repository.TagMetadata{Tag: "work", Emoji: "💼"}},
+			},
+			wantFooter: "Tags: 💼 work",
+		},
 	}
 
 	for _, tt := range tests {
@@ -26,15 +56,20 @@ func TestItemsByBoardItemAccessors(t *testing.T) {
 			base.Title = "task"
 			base.Description = "line 1\nline 2"
 			base.Completed = tt.completed
+			base.DueAt = tt.due
+			base.Recurrence = tt.recurrence
+			base.SubtasksDone, base.SubtasksTotal = tt.subtasks[0], tt.subtasks[1]
+			base.BlockedBy = tt.blockedBy
 
-			item := itemsbyboard.NewItem(&base).(itemsbyboard.Item)
+			item := itemsbyboard.NewItem(&base, tt.meta).(itemsbyboard.Item)
 			assert.Equal(t, "task", item.Title())
 			assert.NotEmpty(t, item.Description())
 			assert.Equal(t, "task", item.FilterValue())
 			assert.Equal(t, tt.wantHidden, item.HideValue())
+			assert.Equal(t, tt.blockedBy > 0, item.Blocked())
 			assert.Equal(t, tt.wantFooter, item.Footer())
 
-			list := itemsbyboard.NewList(&[]service.Item{base})
+			list := itemsbyboard.NewList(&[]service.Item{base}, nil)
 			assert.Len(t, list, 1)
 		})
 	}
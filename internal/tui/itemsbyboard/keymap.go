@@ -1,6 +1,10 @@
 package itemsbyboard
 
-import "charm.land/bubbles/v2/key"
+import (
+	"charm.land/bubbles/v2/key"
+
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+)
 
 type Keymap struct {
 	Back           key.Binding
@@ -8,8 +12,18 @@ type Keymap struct {
 	DeleteItem     key.Binding
 	RenameItem     key.Binding
 	UpdateTags     key.Binding
+	UpdateDue      key.Binding
+	UpdateRepeat   key.Binding
+	CyclePriority  key.Binding
+	SortItems      key.Binding
 	RefreshList    key.Binding
 	ToggleComplete key.Binding
+	MoveToBoard    key.Binding
+	ShowHistory    key.Binding
+	EditBlockers   key.Binding
+	MoveUp         key.Binding
+	MoveDown       key.Binding
+	EditChecklist  key.Binding
 	Cut            key.Binding
 	Copy           key.Binding
 	Paste          key.Binding
@@ -18,7 +32,7 @@ type Keymap struct {
 }
 
 func NewKeymap() *Keymap {
-	return &Keymap{
+	km := &Keymap{
 		Back: key.NewBinding(
 			key.WithKeys("backspace"),
 			key.WithHelp("backspace", "back"),
@@ -39,6 +53,22 @@ func NewKeymap() *Keymap {
 			key.WithKeys("t"),
 			key.WithHelp("t", "update tags"),
 		),
+		UpdateDue: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "set due date"),
+		),
+		UpdateRepeat: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "set recurrence"),
+		),
+		CyclePriority: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "cycle priority"),
+		),
+		SortItems: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "cycle sort order"),
+		),
 		RefreshList: key.NewBinding(
 			key.WithKeys("R"),
 			key.WithHelp("R", "refresh board"),
@@ -47,6 +77,18 @@ func NewKeymap() *Keymap {
 			key.WithKeys("space"),
 			key.WithHelp("space", "toggle complete"),
 		),
+		MoveUp: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "move item up"),
+		),
+		MoveDown: key.NewBinding(
+			key.WithKeys("J"),
+			key.WithHelp("J", "move item down"),
+		),
+		EditChecklist: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "edit checklist"),
+		),
 		Copy: key.NewBinding(
 			key.WithKeys("y"),
 			key.WithKeys("y", "copy"),
@@ -55,6 +97,18 @@ func NewKeymap() *Keymap {
 			key.WithKeys("p"),
 			key.WithKeys("p", "paste"),
 		),
+		MoveToBoard: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "move to board"),
+		),
+		ShowHistory: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "show history"),
+		),
+		EditBlockers: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "edit blockers"),
+		),
 		NextBoard: key.NewBinding(
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "next board"),
@@ -64,6 +118,8 @@ func NewKeymap() *Keymap {
 			key.WithHelp("shift+tab", "previous board"),
 		),
 	}
+	keybindings.Apply("items", km)
+	return km
 }
 
 func (km Keymap) ShortHelp() []key.Binding {
@@ -80,8 +136,18 @@ func (km Keymap) FullHelp() []key.Binding {
 	bindings = append(bindings, km.DeleteItem)
 	bindings = append(bindings, km.RenameItem)
 	bindings = append(bindings, km.UpdateTags)
+	bindings = append(bindings, km.UpdateDue)
+	bindings = append(bindings, km.UpdateRepeat)
+	bindings = append(bindings, km.CyclePriority)
+	bindings = append(bindings, km.SortItems)
 	bindings = append(bindings, km.RefreshList)
 	bindings = append(bindings, km.ToggleComplete)
+	bindings = append(bindings, km.MoveUp)
+	bindings = append(bindings, km.MoveDown)
+	bindings = append(bindings, km.EditChecklist)
+	bindings = append(bindings, km.MoveToBoard)
+	bindings = append(bindings, km.ShowHistory)
+	bindings = append(bindings, km.EditBlockers)
 	bindings = append(bindings, km.NextBoard)
 	bindings = append(bindings, km.PreviousBoard)
 	return bindings
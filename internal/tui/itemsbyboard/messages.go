@@ -7,12 +7,17 @@ type ErrorMsg struct {
 }
 
 type ListItemsMsg struct {
-	Items *[]service.Item
+	Items   *[]service.Item
+	TagMeta map[string]service.TagMetadata
 }
 
 type CreateItemMsg struct {
-	Item  *service.Item
-	Error error
+	Item *service.Item
+	// Board is the board the item was created in, set by quick-add which
+	// may target another board. NewBoard reports that it was created too.
+	Board    *service.Board
+	NewBoard bool
+	Error    error
 }
 
 type RenameItemMsg struct {
@@ -25,8 +30,41 @@ type UpdateTagsMsg struct {
 	Error error
 }
 
+type UpdateDueMsg struct {
+	Item  *service.Item
+	Error error
+}
+
+type UpdateRecurrenceMsg struct {
+	Item  *service.Item
+	Error error
+}
+
+type UpdatePriorityMsg struct {
+	Item  *service.Item
+	Error error
+}
+
 type ToggleItemMsg struct {
 	Item  *service.Item
+	Next  *service.Item
+	Error error
+}
+
+type ReorderItemMsg struct {
+	Item  *service.Item
+	Error error
+}
+
+type UpdateBlockerMsg struct {
+	Blocker  *service.Item
+	Blocking bool
+	Error    error
+}
+
+type MoveItemMsg struct {
+	Item  *service.Item
+	Board *service.Board
 	Error error
 }
 
@@ -7,26 +7,38 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/activity"
+	"github.com/rhajizada/donezo/internal/tui/blockerpicker"
+	"github.com/rhajizada/donezo/internal/tui/boardpicker"
 	"github.com/rhajizada/donezo/internal/tui/boards"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
 	"github.com/rhajizada/donezo/internal/tui/itemlist"
+	"github.com/rhajizada/donezo/internal/tui/tagcomplete"
 )
 
 //nolint:recvcheck // Mixed receivers align with tea.Model usage patterns.
 type MenuModel struct {
-	ctx     context.Context
-	Parent  *boards.MenuModel
-	List    itemlist.Model
-	Input   textinput.Model
-	Keys    *Keymap
-	Context *InputContext
-	Service *service.Service
+	ctx       context.Context
+	Parent    *boards.MenuModel
+	List      itemlist.Model
+	Input     textinput.Model
+	Keys      *Keymap
+	Context   *InputContext
+	Order     service.ItemOrder
+	Picker    boardpicker.Model
+	History   activity.Panel
+	Blockers  blockerpicker.Model
+	Completer tagcomplete.Model
+	TagMeta   map[string]service.TagMetadata
+	Clipboard clipboard.Clipboard
+	Service   *service.Service
 }
 
 func (m MenuModel) Init() tea.Cmd {
 	return m.ListItems()
 }
 
-func New(ctx context.Context, service *service.Service, parent *boards.MenuModel) MenuModel {
+func New(ctx context.Context, svc *service.Service, parent *boards.MenuModel) MenuModel {
 	list := itemlist.New(
 		[]itemlist.Item{},
 		NewDelegate(),
@@ -45,12 +57,14 @@ func New(ctx context.Context, service *service.Service, parent *boards.MenuModel
 	list.AdditionalFullHelpKeys = keymap.FullHelp
 
 	return MenuModel{
-		ctx:     ctx,
-		Parent:  parent,
-		List:    list,
-		Input:   input,
-		Keys:    keymap,
-		Context: inputContext,
-		Service: service,
+		ctx:       ctx,
+		Parent:    parent,
+		List:      list,
+		Input:     input,
+		Keys:      keymap,
+		Context:   inputContext,
+		Clipboard: clipboard.Current(),
+		Service:   svc,
+		Order:     service.OrderByPosition,
 	}
 }
@@ -4,29 +4,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
-	"golang.design/x/clipboard"
-
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/activity"
+	"github.com/rhajizada/donezo/internal/tui/blockerpicker"
+	"github.com/rhajizada/donezo/internal/tui/boardpicker"
 	"github.com/rhajizada/donezo/internal/tui/boards"
 	"github.com/rhajizada/donezo/internal/tui/helpers"
+	"github.com/rhajizada/donezo/internal/tui/itemlist"
+	"github.com/rhajizada/donezo/internal/tui/navigation"
 	"github.com/rhajizada/donezo/internal/tui/styles"
+	"github.com/rhajizada/donezo/internal/tui/tagcomplete"
 
 	tea "charm.land/bubbletea/v2"
 )
 
-var (
-	//nolint:gochecknoglobals // injectable for tests
-	writeClipboardText = func(data []byte) {
-		clipboard.Write(clipboard.FmtText, data)
-	}
-	//nolint:gochecknoglobals // injectable for tests
-	readClipboardText = func() []byte {
-		return clipboard.Read(clipboard.FmtText)
-	}
-)
-
 func (m *MenuModel) selectedItem() (Item, bool) {
 	item, ok := m.List.SelectedItem().(Item)
 	return item, ok
@@ -41,13 +35,21 @@ func (m *MenuModel) selectedBoard() (boards.Item, bool) {
 	return item, ok
 }
 
-// Copy copies selected item to clipboard and moves it to ItemStack.
+// Copy copies selected item with its checklist to clipboard and moves it to ItemStack.
 func (m *MenuModel) Copy() tea.Cmd {
 	selected, ok := m.selectedItem()
 	if !ok {
 		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
 	}
 
+	subtasks, err := m.Service.ListSubtasks(m.ctx, &selected.Itm)
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{err}
+		}
+	}
+	selected.Itm.Subtasks = *subtasks
+
 	data, err := json.Marshal(selected.Itm)
 	if err != nil {
 		return func() tea.Msg {
@@ -55,12 +57,15 @@ func (m *MenuModel) Copy() tea.Cmd {
 		}
 	}
 
-	writeClipboardText(data)
-	return m.List.NewStatusMessage(
+	copyCmd, err := m.Clipboard.Write(data)
+	if err != nil {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render(err.Error()))
+	}
+	return tea.Batch(copyCmd, m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
-			fmt.Sprintf("copied \"%s\" to system clipboard", selected.Itm.Title),
+			fmt.Sprintf("copied \"%s\" to clipboard", selected.Itm.Title),
 		),
-	)
+	))
 }
 
 // Paste pastes item into current board.
@@ -70,9 +75,16 @@ func (m *MenuModel) Paste() tea.Cmd {
 		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no board selected"))
 	}
 
-	data := readClipboardText()
+	data, err := m.Clipboard.Read()
+	if err != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("nothing to paste: %v", err),
+			),
+		)
+	}
 	var lastItem service.Item
-	err := json.Unmarshal(data, &lastItem)
+	err = json.Unmarshal(data, &lastItem)
 	if err != nil {
 		return m.List.NewStatusMessage(
 			styles.ErrorMessage.Render(
@@ -81,18 +93,18 @@ func (m *MenuModel) Paste() tea.Cmd {
 		)
 	}
 
-	item, err := m.Service.CreateItem(m.ctx, &currentBoard.Board, lastItem.Title, lastItem.Description)
-	if err != nil {
-		return func() tea.Msg {
-			return ErrorMsg{err}
-		}
-	}
-	item.Tags = lastItem.Tags
-	item.Completed = lastItem.Completed
-	item, err = m.Service.UpdateItem(m.ctx, item)
+	item, err := m.Service.CreateItemFrom(m.ctx, &currentBoard.Board, &lastItem)
 	return func() tea.Msg {
-		return CreateItemMsg{item, err}
+		return CreateItemMsg{Item: item, Error: err}
+	}
+}
+
+// EditChecklist opens the checklist of the selected item.
+func (m *MenuModel) EditChecklist() tea.Cmd {
+	if _, ok := m.selectedItem(); !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
 	}
+	return func() tea.Msg { return navigation.OpenSubtasksMsg{} }
 }
 
 // ListItems fetches items in the selected board.
@@ -107,39 +119,98 @@ func (m *MenuModel) ListItems() tea.Cmd {
 		if err != nil {
 			return ErrorMsg{err}
 		}
+		meta, err := m.Service.ListTagMetadata(m.ctx)
+		if err != nil {
+			return ErrorMsg{err}
+		}
 		return ListItemsMsg{
-			items,
+			Items:   items,
+			TagMeta: meta,
 		}
 	}
 }
 
-// CreateItem creates a new item.
+// CreateItem creates the item described by the quick-add line in
+// Context.Board, creating the board named by the line first when
+// Context.Board is nil.
 func (m *MenuModel) CreateItem() tea.Cmd {
+	q, board := m.Context.Quick, m.Context.Board
 	return func() tea.Msg {
-		parentItem, ok := m.selectedBoard()
-		if !ok {
-			return CreateItemMsg{
-				Item:  nil,
-				Error: errors.New("no board selected"),
+		newBoard := board == nil
+		if newBoard {
+			var err error
+			if board, err = m.Service.CreateBoard(m.ctx, q.Board); err != nil {
+				return CreateItemMsg{Error: fmt.Errorf("failed creating board: %w", err)}
 			}
 		}
-
-		item, err := m.Service.CreateItem(m.ctx, &parentItem.Board, m.Context.Title, m.Context.Desc)
+		item, err := m.Service.CreateQuickAdd(m.ctx, board, q)
 		return CreateItemMsg{
-			item,
-			err,
+			Item:     item,
+			Board:    board,
+			NewBoard: newBoard,
+			Error:    err,
 		}
 	}
 }
 
 func (m *MenuModel) InitCreateItem() tea.Cmd {
 	m.Context.State = CreateItemNameState
-	m.Input.Placeholder = "Enter item name"
+	m.Context.Quick = service.QuickAdd{}
+	m.Context.Board = nil
+	m.Input.Placeholder = "Enter item, e.g. Pay rent #finance @Personal -- rent for november"
 	m.Input.SetValue("")
 	m.Input.Focus()
 	return nil
 }
 
+// SubmitCreateItem resolves the board of the quick-add line once its
+// description is known. The item is created right away when the board
+// exists, otherwise the user is asked whether to create the board.
+func (m *MenuModel) SubmitCreateItem() tea.Cmd {
+	m.Input.Blur()
+	m.Context.State = DefaultState
+	m.Context.Board = nil
+	if m.Context.Quick.Board == "" {
+		current, ok := m.selectedBoard()
+		if !ok {
+			return m.List.NewStatusMessage(styles.ErrorMessage.Render("no board selected"))
+		}
+		m.Context.Board = &current.Board
+		return m.CreateItem()
+	}
+
+	boardList, err := m.Service.ListBoards(m.ctx)
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{err}
+		}
+	}
+	m.Context.Board = findBoard(*boardList, m.Context.Quick.Board)
+	if m.Context.Board == nil {
+		m.Context.State = CreateItemBoardState
+		return nil
+	}
+	return m.CreateItem()
+}
+
+// quickAddPreview describes the item typed in the name input, or why it
+// cannot be created.
+func (m *MenuModel) quickAddPreview() string {
+	if strings.TrimSpace(m.Input.Value()) == "" {
+		return ""
+	}
+	q, err := service.ParseQuickAdd(m.Input.Value())
+	if err != nil {
+		return styles.ErrorMessage.Render(err.Error())
+	}
+	if q.Board == "" {
+		if current, ok := m.selectedBoard(); ok {
+			q.Board = current.Board.Name
+		}
+	}
+	return styles.StatusMessage.Render(q.String())
+}
+
 // RenameItem renames selected item.
 func (m *MenuModel) RenameItem() tea.Cmd {
 	return func() tea.Msg {
@@ -183,6 +254,62 @@ func (m *MenuModel) UpdateTags() tea.Cmd {
 	}
 }
 
+// UpdateDue updates the due date of the selected item.
+func (m *MenuModel) UpdateDue() tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return UpdateDueMsg{Error: errors.New("no item selected")}
+		}
+		due, err := helpers.ParseDueDate(m.Context.Title)
+		if err != nil {
+			return UpdateDueMsg{Error: err}
+		}
+
+		selected.Itm.DueAt = due
+		item, err := m.Service.UpdateItem(m.ctx, &selected.Itm)
+		return UpdateDueMsg{
+			item,
+			err,
+		}
+	}
+}
+
+// UpdateRecurrence updates the recurrence rule of the selected item.
+func (m *MenuModel) UpdateRecurrence() tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return UpdateRecurrenceMsg{Error: errors.New("no item selected")}
+		}
+
+		selected.Itm.Recurrence = m.Context.Title
+		item, err := m.Service.UpdateItem(m.ctx, &selected.Itm)
+		return UpdateRecurrenceMsg{
+			item,
+			err,
+		}
+	}
+}
+
+// SortItems switches to the next sort order and re-sorts the listed items.
+func (m *MenuModel) SortItems() tea.Cmd {
+	m.Order = m.Order.Next()
+	items := make([]service.Item, 0, len(m.List.Items()))
+	for _, listItem := range m.List.Items() {
+		if item, ok := listItem.(Item); ok {
+			items = append(items, item.Itm)
+		}
+	}
+	service.SortItems(items, m.Order)
+	m.List.SetItems(NewList(&items, m.TagMeta))
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("sorted by %s", m.Order),
+		),
+	)
+}
+
 // InitRenameItem starts the renaming process for the selected item.
 func (m *MenuModel) InitRenameItem() tea.Cmd {
 	if len(m.List.Items()) == 0 {
@@ -200,7 +327,7 @@ func (m *MenuModel) InitRenameItem() tea.Cmd {
 	return nil
 }
 
-// InitUpdateTags initializes tag updates.
+// InitUpdateTags initializes tag updates with completion of existing tags.
 func (m *MenuModel) InitUpdateTags() tea.Cmd {
 	m.Context.State = UpdateTagsState
 	m.Input.Placeholder = "Enter comma-separated list of tags"
@@ -211,12 +338,165 @@ func (m *MenuModel) InitUpdateTags() tea.Cmd {
 		m.Input.CursorEnd()
 	}
 	m.Input.Focus()
+
+	completer, err := tagcomplete.Load(m.ctx, m.Service)
+	if err != nil {
+		// Editing still works without suggestions.
+		m.Completer = tagcomplete.New(nil)
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(fmt.Sprintf("failed loading tags: %v", err)),
+		)
+	}
+	m.Completer = completer
+	m.Completer.SetValue(m.Input.Value())
+	return nil
+}
+
+// InitUpdateDue initializes due date updates.
+func (m *MenuModel) InitUpdateDue() tea.Cmd {
+	if len(m.List.Items()) == 0 {
+		return m.List.NewStatusMessage(
+			styles.StatusMessage.Render("no item selected"))
+	}
+
+	m.Context.State = UpdateDueState
+	m.Input.Placeholder = fmt.Sprintf("Enter due date (%s), leave empty to clear", helpers.DueDateLayout)
+	selected, ok := m.selectedItem()
+	if ok {
+		m.Input.SetValue(helpers.FormatDueDate(selected.Itm.DueAt))
+		m.Input.CursorEnd()
+	}
+	m.Input.Focus()
+	return nil
+}
+
+// InitUpdateRecurrence initializes recurrence rule updates.
+func (m *MenuModel) InitUpdateRecurrence() tea.Cmd {
+	if len(m.List.Items()) == 0 {
+		return m.List.NewStatusMessage(
+			styles.StatusMessage.Render("no item selected"))
+	}
+
+	m.Context.State = UpdateRecurrenceState
+	m.Input.Placeholder = "daily, weekly mon,fri, monthly 15 or every 3 days; leave empty to stop repeating"
+	selected, ok := m.selectedItem()
+	if ok {
+		m.Input.SetValue(selected.Itm.Recurrence)
+		m.Input.CursorEnd()
+	}
+	m.Input.Focus()
+	return nil
+}
+
+// InitMoveItem opens the board picker for the selected item.
+func (m *MenuModel) InitMoveItem() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	boardList, err := m.Service.ListBoards(m.ctx)
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{err}
+		}
+	}
+	if len(*boardList) < 2 { //nolint:mnd // the current board and at least one other
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no other board to move to"))
+	}
+
+	m.Picker = boardpicker.New(boardList, selected.Itm.BoardID)
+	m.Picker.SetSize(m.List.Width(), m.List.Height())
+	m.Context.State = MoveItemState
+	return nil
+}
+
+// InitHistory opens the history panel for the selected item.
+func (m *MenuModel) InitHistory() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	entries, err := m.Service.ListActivity(m.ctx, service.ActivityFilter{ItemID: selected.Itm.ID})
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{err}
+		}
+	}
+
+	m.History = activity.NewPanel(&selected.Itm, entries)
+	m.History.SetSize(m.List.Width(), m.List.Height())
+	m.Context.State = HistoryState
 	return nil
 }
 
+// InitBlockers opens the blocker picker for the selected item.
+func (m *MenuModel) InitBlockers() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	picker, err := blockerpicker.Load(m.ctx, m.Service, &selected.Itm)
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{err}
+		}
+	}
+	if len(picker.List.Items()) == 0 {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no other items to depend on"))
+	}
+
+	m.Blockers = picker
+	m.Blockers.SetSize(m.List.Width(), m.List.Height())
+	m.Context.State = BlockersState
+	return nil
+}
+
+// UpdateBlocker adds or removes blocker from the blockers of the selected item.
+func (m *MenuModel) UpdateBlocker(blocker service.Item, blocking bool) tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return UpdateBlockerMsg{Error: errors.New("no item selected")}
+		}
+		var err error
+		if blocking {
+			err = m.Service.AddBlocker(m.ctx, &selected.Itm, &blocker)
+		} else {
+			err = m.Service.RemoveBlocker(m.ctx, &selected.Itm, &blocker)
+		}
+		return UpdateBlockerMsg{
+			Blocker:  &blocker,
+			Blocking: blocking,
+			Error:    err,
+		}
+	}
+}
+
+// MoveItem moves the selected item to board.
+func (m *MenuModel) MoveItem(board service.Board) tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return MoveItemMsg{Error: errors.New("no item selected")}
+		}
+		item, err := m.Service.MoveItem(m.ctx, &selected.Itm, &board)
+		return MoveItemMsg{
+			item,
+			&board,
+			err,
+		}
+	}
+}
+
+func (m *MenuModel) indexOf(id int64) int {
+	return slices.IndexFunc(m.List.Items(), func(i itemlist.Item) bool {
+		item, ok := i.(Item)
+		return ok && item.Itm.ID == id
+	})
+}
+
 // DeleteItem deletes current selected item.
 func (m *MenuModel) DeleteItem() tea.Cmd {
-	m.Copy()
 	selected, ok := m.selectedItem()
 	if !ok {
 		return func() tea.Msg {
@@ -240,18 +520,99 @@ func (m MenuModel) ToggleComplete() tea.Cmd {
 	if !ok {
 		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
 	}
+	original := selected.Itm
 	selected.Itm.Completed = !selected.Itm.Completed
 	m.List.SetItem(m.List.Index(), selected)
 
+	return func() tea.Msg {
+		i, next, err := m.Service.ToggleItem(m.ctx, &original)
+		return ToggleItemMsg{Item: i, Next: next, Error: err}
+	}
+}
+
+// ReorderItem moves selected item by delta places among the visible items in
+// place and saves the new order. Only the manual order can be changed.
+func (m *MenuModel) ReorderItem(delta int) tea.Cmd {
+	if m.Order != service.OrderByPosition {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(fmt.Sprintf("sort by %s to reorder items", service.OrderByPosition)),
+		)
+	}
+	if m.List.IsFiltered() {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("clear the filter to reorder items"))
+	}
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	visible := m.List.VisibleItems()
+	index := m.List.Index()
+	if index+delta < 0 || index+delta >= len(visible) {
+		return nil
+	}
+	neighbour, ok := visible[index+delta].(Item)
+	if !ok {
+		return nil
+	}
+
+	// Hidden completed items may sit between the two, so move within the full list.
+	items := slices.Clone(m.List.Items())
+	indexOf := func(id int64) int {
+		return slices.IndexFunc(items, func(i itemlist.Item) bool {
+			it, isItem := i.(Item)
+			return isItem && it.Itm.ID == id
+		})
+	}
+	from, to := indexOf(selected.Itm.ID), indexOf(neighbour.Itm.ID)
+	items = slices.Insert(slices.Delete(items, from, from+1), to, itemlist.Item(selected))
+	for i, listItem := range items {
+		if it, isItem := listItem.(Item); isItem {
+			it.Itm.Position = int64(i)
+			items[i] = it
+		}
+	}
+	m.List.SetItems(items)
+	m.List.Select(index + delta)
+
+	return func() tea.Msg {
+		err := m.Service.MoveItemTo(m.ctx, &selected.Itm, to)
+		return ReorderItemMsg{Item: &selected.Itm, Error: err}
+	}
+}
+
+// CyclePriority moves the selected item to the next priority level.
+func (m MenuModel) CyclePriority() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	selected.Itm.Priority = int64(service.Priority(selected.Itm.Priority).Next())
+	m.List.SetItem(m.List.Index(), selected)
+
 	return func() tea.Msg {
 		i, err := m.Service.UpdateItem(m.ctx, &selected.Itm)
-		return ToggleItemMsg{i, err}
+		return UpdatePriorityMsg{i, err}
 	}
 }
 
 func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if m.Context.State == MoveItemState {
+		cmd := m.HandlePickerState(msg)
+		return m, cmd
+	}
+
+	if m.Context.State == BlockersState {
+		cmd := m.HandleBlockersState(msg)
+		return m, cmd
+	}
+
+	if m.Context.State == HistoryState {
+		cmd := m.HandleHistoryState(msg)
+		return m, cmd
+	}
+
 	if m.Context.State != DefaultState {
 		m.Input, cmds = m.HandleInputState(msg)
 		return m, tea.Batch(cmds...)
@@ -271,7 +632,9 @@ func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 
 	case ListItemsMsg:
-		m.List.SetItems(NewList(msg.Items))
+		service.SortItems(*msg.Items, m.Order)
+		m.TagMeta = msg.TagMeta
+		m.List.SetItems(NewList(msg.Items, m.TagMeta))
 
 	case CreateItemMsg:
 		cmd := m.HandleCreateItem(msg)
@@ -291,9 +654,29 @@ func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := m.HandleUpdateTags(msg)
 		cmds = append(cmds, cmd)
 
+	case UpdateDueMsg:
+		cmd := m.HandleUpdateDue(msg)
+		cmds = append(cmds, cmd)
+
+	case ReorderItemMsg:
+		cmd := m.HandleReorderItem(msg)
+		cmds = append(cmds, cmd)
+
+	case UpdateRecurrenceMsg:
+		cmd := m.HandleUpdateRecurrence(msg)
+		cmds = append(cmds, cmd)
+
+	case UpdatePriorityMsg:
+		cmd := m.HandleUpdatePriority(msg)
+		cmds = append(cmds, cmd)
+
 	case ToggleItemMsg:
 		cmd := m.HandleToggleItem(msg)
 		cmds = append(cmds, cmd)
+
+	case MoveItemMsg:
+		cmd := m.HandleMoveItem(msg)
+		cmds = append(cmds, cmd)
 	}
 
 	listModel, listCmd := m.List.Update(msg)
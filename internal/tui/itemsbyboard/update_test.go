@@ -2,6 +2,7 @@ package itemsbyboard
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
@@ -11,7 +12,10 @@ import (
 	"github.com/rhajizada/donezo/internal/repository"
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/blockerpicker"
+	"github.com/rhajizada/donezo/internal/tui/boardpicker"
 	"github.com/rhajizada/donezo/internal/tui/boards"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
 )
 
 func TestCopySavesItemJSON(t *testing.T) {
@@ -29,12 +33,14 @@ func TestCopySavesItemJSON(t *testing.T) {
 			ctx := testutil.MustContext()
 			board, err := svc.CreateBoard(ctx, "Inbox")
 			require.NoError(t, err)
-			item, err := svc.CreateItem(ctx, board, "title", "desc")
+			item, err := svc.CreateItem(ctx, board, "title", "desc", nil)
 			require.NoError(t, err)
 			item.Tags = []string{"tag1", "tag2"}
 			item.Completed = true
 			item, err = svc.UpdateItem(ctx, item)
 			require.NoError(t, err)
+			_, err = svc.AddSubtask(ctx, item, "step")
+			require.NoError(t, err)
 			items, err := svc.ListItemsByBoard(ctx, board)
 			require.NoError(t, err)
 
@@ -42,18 +48,17 @@ func TestCopySavesItemJSON(t *testing.T) {
 			parent.List.SetItems(boards.NewList(&[]service.Board{*board}))
 			parent.List.Select(0)
 			menu := New(ctx, svc, &parent)
-			menu.List.SetItems(NewList(items))
+			menu.List.SetItems(NewList(items, nil))
 			menu.List.Select(0)
 
-			var captured []byte
-			prevWrite := writeClipboardText
-			writeClipboardText = func(data []byte) { captured = append([]byte{}, data...) }
-			defer func() { writeClipboardText = prevWrite }()
+			menu.Clipboard = clipboard.NewRegister("")
 
 			cmd := menu.Copy()
 			if cmd != nil {
 				cmd()
 			}
+			captured, err := menu.Clipboard.Read()
+			require.NoError(t, err)
 			assert.NotEmpty(t, captured)
 
 			var saved service.Item
@@ -62,6 +67,8 @@ func TestCopySavesItemJSON(t *testing.T) {
 			assert.Equal(t, item.Description, saved.Description)
 			assert.True(t, saved.Completed)
 			assert.Len(t, saved.Tags, len(item.Tags))
+			require.Len(t, saved.Subtasks, 1)
+			assert.Equal(t, "step", saved.Subtasks[0].Title)
 		})
 	}
 }
@@ -81,6 +88,8 @@ func TestPasteCreatesItemOnSelectedBoard(t *testing.T) {
 			ctx := testutil.MustContext()
 			board, err := svc.CreateBoard(ctx, "Inbox")
 			require.NoError(t, err)
+			board, err = svc.SetBoardDefaultTags(ctx, board, []string{"inbox", "work"})
+			require.NoError(t, err)
 
 			parent := boards.New(ctx, svc)
 			parent.List.SetItems(boards.NewList(&[]service.Board{*board}))
@@ -94,13 +103,17 @@ func TestPasteCreatesItemOnSelectedBoard(t *testing.T) {
 					Completed:   true,
 				},
 				Tags: []string{"work", "go"},
+				Subtasks: []service.Subtask{
+					{Subtask: repository.Subtask{Title: "first", Completed: true}},
+					{Subtask: repository.Subtask{Title: "second"}},
+				},
 			}
 			data, err := json.Marshal(clipItem)
 			require.NoError(t, err)
 
-			prevRead := readClipboardText
-			readClipboardText = func() []byte { return data }
-			defer func() { readClipboardText = prevRead }()
+			menu.Clipboard = clipboard.NewRegister("")
+			_, err = menu.Clipboard.Write(data)
+			require.NoError(t, err)
 
 			cmd := menu.Paste()
 			require.NotNil(t, cmd)
@@ -112,7 +125,15 @@ func TestPasteCreatesItemOnSelectedBoard(t *testing.T) {
 			assert.Equal(t, clipItem.Title, created.Item.Title)
 			assert.Equal(t, clipItem.Description, created.Item.Description)
 			assert.True(t, created.Item.Completed)
-			assert.Len(t, created.Item.Tags, len(clipItem.Tags))
+			assert.Equal(t, []string{"inbox", "work", "go"}, created.Item.Tags)
+			assert.Equal(t, int64(2), created.Item.SubtasksTotal)
+			assert.Equal(t, int64(1), created.Item.SubtasksDone)
+
+			subtasks, err := svc.ListSubtasks(ctx, created.Item)
+			require.NoError(t, err)
+			require.Len(t, *subtasks, 2)
+			assert.Equal(t, "first", (*subtasks)[0].Title)
+			assert.True(t, (*subtasks)[0].Completed)
 
 			items, err := svc.ListItemsByBoard(ctx, board)
 			require.NoError(t, err)
@@ -197,10 +218,6 @@ func TestItemCreateRenameToggleTagDeleteFlow(t *testing.T) {
 			require.True(t, ok)
 			assert.Len(t, tagged.Itm.Tags, 2)
 
-			prevWrite := writeClipboardText
-			writeClipboardText = func(_ []byte) {}
-			defer func() { writeClipboardText = prevWrite }()
-
 			cmd = menu.DeleteItem()
 			require.NotNil(t, cmd)
 			msg := cmd()
@@ -218,3 +235,438 @@ func TestItemCreateRenameToggleTagDeleteFlow(t *testing.T) {
 		})
 	}
 }
+
+func TestToggleRecurringItemReloadsWithNextOccurrence(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board, err := svc.CreateBoard(ctx, "Chores")
+	require.NoError(t, err)
+	item, err := svc.CreateItem(ctx, board, "water plants", "", nil)
+	require.NoError(t, err)
+	item.Recurrence = "every 2 days"
+	_, err = svc.UpdateItem(ctx, item)
+	require.NoError(t, err)
+	items, err := svc.ListItemsByBoard(ctx, board)
+	require.NoError(t, err)
+
+	parent := boards.New(ctx, svc)
+	parent.List.SetItems(boards.NewList(&[]service.Board{*board}))
+	parent.List.Select(0)
+	menu := New(ctx, svc, &parent)
+	menu.List.SetItems(NewList(items, nil))
+	menu.List.Select(0)
+
+	cmd := menu.ToggleComplete()
+	require.NotNil(t, cmd)
+	toggled, ok := cmd().(ToggleItemMsg)
+	require.True(t, ok)
+	require.NoError(t, toggled.Error)
+	require.NotNil(t, toggled.Next)
+	assert.Equal(t, "every 2 days", toggled.Next.Recurrence)
+
+	model, cmd := menu.Update(toggled)
+	menu = model.(MenuModel)
+	require.NotNil(t, cmd)
+	for _, msg := range collectBatch(cmd) {
+		if listed, isList := msg.(ListItemsMsg); isList {
+			model, _ = menu.Update(listed)
+			menu = model.(MenuModel)
+		}
+	}
+	assert.Len(t, menu.List.Items(), 2)
+}
+
+func collectBatch(cmd tea.Cmd) []tea.Msg {
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		if c != nil {
+			msgs = append(msgs, collectBatch(c)...)
+		}
+	}
+	return msgs
+}
+
+func TestReorderItemSkipsHiddenItemsAndPersists(t *testing.T) {
+	tests := []struct {
+		name      string
+		selected  int
+		key       tea.KeyPressMsg
+		wantOrder []string
+		wantIndex int
+	}{
+		{
+			name:      "move down past hidden completed item",
+			selected:  0,
+			key:       tea.KeyPressMsg{Code: 'J', Text: "J"},
+			wantOrder: []string{"b", "c", "a"},
+			wantIndex: 1,
+		},
+		{
+			name:      "move up past hidden completed item",
+			selected:  1,
+			key:       tea.KeyPressMsg{Code: 'K', Text: "K"},
+			wantOrder: []string{"c", "a", "b"},
+			wantIndex: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board, err := svc.CreateBoard(ctx, "Ordered")
+			require.NoError(t, err)
+			for _, title := range []string{"a", "b", "c"} {
+				item, createErr := svc.CreateItem(ctx, board, title, "", nil)
+				require.NoError(t, createErr)
+				if title == "b" {
+					item.Completed = true
+					_, err = svc.UpdateItem(ctx, item)
+					require.NoError(t, err)
+				}
+			}
+			items, err := svc.ListItemsByBoard(ctx, board)
+			require.NoError(t, err)
+
+			parent := boards.New(ctx, svc)
+			parent.List.SetItems(boards.NewList(&[]service.Board{*board}))
+			parent.List.Select(0)
+			menu := New(ctx, svc, &parent)
+			menu.List.SetItems(NewList(items, nil))
+			menu.List.ToggleHide()
+			menu.List.Select(tt.selected)
+
+			model, cmd := menu.Update(tt.key)
+			menu = model.(MenuModel)
+			require.NotNil(t, cmd)
+			assert.Equal(t, tt.wantIndex, menu.List.Index())
+
+			titles := make([]string, len(menu.List.Items()))
+			for i, listItem := range menu.List.Items() {
+				titles[i] = listItem.(Item).Itm.Title
+			}
+			assert.Equal(t, tt.wantOrder, titles)
+
+			for _, msg := range collectBatch(cmd) {
+				if reordered, ok := msg.(ReorderItemMsg); ok {
+					require.NoError(t, reordered.Error)
+				}
+			}
+			saved, err := svc.ListItemsByBoard(ctx, board)
+			require.NoError(t, err)
+			for i, item := range *saved {
+				assert.Equal(t, tt.wantOrder[i], item.Title)
+			}
+		})
+	}
+
+	t.Run("reordering requires manual order", func(t *testing.T) {
+		menu, cleanup := newItemMenu(t)
+		defer cleanup()
+
+		menu.Order = service.OrderByPriority
+		cmd := menu.ReorderItem(1)
+		require.NotNil(t, cmd)
+		for _, msg := range collectBatch(cmd) {
+			_, ok := msg.(ReorderItemMsg)
+			assert.False(t, ok)
+		}
+	})
+}
+
+func TestMoveItemWithBoardPicker(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	source, err := svc.CreateBoard(ctx, "Source")
+	require.NoError(t, err)
+	target, err := svc.CreateBoard(ctx, "Target")
+	require.NoError(t, err)
+	item, err := svc.CreateItem(ctx, source, "task", "desc", nil)
+	require.NoError(t, err)
+
+	parent := boards.New(ctx, svc)
+	parent.List.SetItems(boards.NewList(&[]service.Board{*source, *target}))
+	parent.List.Select(0)
+	menu := New(ctx, svc, &parent)
+	menu.List.SetItems(NewList(&[]service.Item{*item}, nil))
+	menu.List.Select(0)
+
+	model, _ := menu.Update(tea.KeyPressMsg{Code: 'm', Text: "m"})
+	menu = model.(MenuModel)
+	require.Equal(t, MoveItemState, menu.Context.State)
+	assert.Contains(t, menu.View().Content, "Target")
+
+	steps := []tea.Msg{tea.KeyPressMsg{Code: tea.KeyEnter}}
+	for len(steps) > 0 {
+		var cmd tea.Cmd
+		model, cmd = menu.Update(steps[0])
+		menu = model.(MenuModel)
+		steps = steps[1:]
+		if cmd == nil {
+			continue
+		}
+		switch msg := cmd().(type) {
+		case boardpicker.PickedMsg, MoveItemMsg:
+			steps = append(steps, msg)
+		}
+	}
+
+	assert.Equal(t, DefaultState, menu.Context.State)
+	assert.Empty(t, menu.List.Items())
+	items, err := svc.ListItemsByBoard(ctx, target)
+	require.NoError(t, err)
+	require.Len(t, *items, 1)
+	assert.Equal(t, item.ID, (*items)[0].ID)
+}
+
+func TestItemHistoryPanel(t *testing.T) {
+	menu, cleanup := newItemMenu(t)
+	defer cleanup()
+
+	model, _ := menu.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	menu = model.(MenuModel)
+	model, _ = menu.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	menu = model.(MenuModel)
+	require.Equal(t, HistoryState, menu.Context.State)
+	assert.Contains(t, menu.View().Content, "task | History")
+	assert.Contains(t, menu.View().Content, `created item "task"`)
+
+	model, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEsc})
+	menu = model.(MenuModel)
+	require.NotNil(t, cmd)
+	model, _ = menu.Update(cmd())
+	menu = model.(MenuModel)
+	assert.Equal(t, DefaultState, menu.Context.State)
+}
+
+func TestEditBlockersAndUnblockOnCompletion(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board, err := svc.CreateBoard(ctx, "Inbox")
+	require.NoError(t, err)
+	for _, title := range []string{"ship", "build"} {
+		_, err = svc.CreateItem(ctx, board, title, "", nil)
+		require.NoError(t, err)
+	}
+	parent := boards.New(ctx, svc)
+	parent.List.SetItems(boards.NewList(&[]service.Board{*board}))
+	parent.List.Select(0)
+	menu := New(ctx, svc, &parent)
+
+	run := func(msg tea.Msg) {
+		t.Helper()
+		steps := []tea.Msg{msg}
+		for len(steps) > 0 {
+			var cmd tea.Cmd
+			model, cmd := menu.Update(steps[0])
+			menu = model.(MenuModel)
+			steps = steps[1:]
+			if cmd == nil {
+				continue
+			}
+			for _, next := range collectBatch(cmd) {
+				switch next.(type) {
+				case ListItemsMsg, blockerpicker.ToggledMsg, blockerpicker.ClosedMsg, UpdateBlockerMsg, ToggleItemMsg:
+					steps = append(steps, next)
+				}
+			}
+		}
+	}
+
+	run(tea.WindowSizeMsg{Width: 120, Height: 40})
+	run(menu.ListItems()())
+	menu.List.Select(0)
+
+	run(tea.KeyPressMsg{Code: 'B', Text: "B"})
+	require.Equal(t, BlockersState, menu.Context.State)
+	assert.Contains(t, menu.View().Content, "ship | Blocked by")
+	run(tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.Contains(t, menu.View().Content, "[x] build")
+	run(tea.KeyPressMsg{Code: tea.KeyEsc})
+	require.Equal(t, DefaultState, menu.Context.State)
+	assert.Contains(t, menu.View().Content, "ship [blocked by 1]")
+
+	menu.List.ToggleActionable()
+	require.Len(t, menu.List.VisibleItems(), 1)
+	assert.Equal(t, "build", menu.List.VisibleItems()[0].(Item).Itm.Title)
+	menu.List.ToggleActionable()
+
+	// Completing the last blocker reloads the list so "ship" is unblocked.
+	menu.List.Select(1)
+	run(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	assert.NotContains(t, menu.View().Content, "blocked by")
+	shipItem := menu.List.Items()[0].(Item)
+	assert.False(t, shipItem.Blocked())
+}
+
+func TestUpdateTagsCompletesExistingTags(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board, err := svc.CreateBoard(ctx, "Inbox")
+	require.NoError(t, err)
+	for _, tags := range [][]string{{"work", "errands"}, {"work"}, {"wishlist"}, nil} {
+		item, createErr := svc.CreateItem(ctx, board, "task", "", nil)
+		require.NoError(t, createErr)
+		item.Tags = tags
+		_, err = svc.UpdateItem(ctx, item)
+		require.NoError(t, err)
+	}
+	parent := boards.New(ctx, svc)
+	parent.List.SetItems(boards.NewList(&[]service.Board{*board}))
+	parent.List.Select(0)
+	menu := New(ctx, svc, &parent)
+	model, _ := menu.Update(menu.ListItems()())
+	menu = model.(MenuModel)
+	menu.List.Select(3)
+
+	model, _ = menu.Update(tea.KeyPressMsg{Code: 't', Text: "t"})
+	menu = model.(MenuModel)
+	require.Equal(t, UpdateTagsState, menu.Context.State)
+	assert.Equal(t, []string{"work", "errands", "wishlist"}, menu.Completer.Suggestions())
+
+	for _, msg := range []tea.KeyPressMsg{
+		{Code: 'w', Text: "w"},
+		{Code: tea.KeyDown},
+		{Code: tea.KeyTab},
+		{Code: ',', Text: ","},
+		{Code: ' ', Text: " "},
+	} {
+		model, _ = menu.Update(msg)
+		menu = model.(MenuModel)
+	}
+	assert.Equal(t, "wishlist, ", menu.Input.Value())
+	assert.Contains(t, menu.View().Content, "> work (2)")
+	assert.NotContains(t, menu.View().Content, "wishlist (1)")
+
+	model, _ = menu.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	menu = model.(MenuModel)
+	assert.Equal(t, "wishlist, work", menu.Input.Value())
+
+	model, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	menu = model.(MenuModel)
+	require.Equal(t, DefaultState, menu.Context.State)
+	for _, msg := range collectBatch(cmd) {
+		if updated, ok := msg.(UpdateTagsMsg); ok {
+			require.NoError(t, updated.Error)
+			assert.Equal(t, []string{"wishlist", "work"}, updated.Item.Tags)
+		}
+	}
+}
+
+func TestQuickAddCreateFlow(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		keys        []tea.KeyPressMsg
+		wantState   InputState
+		wantCurrent []string
+		wantOther   []string
+		wantBoards  int
+	}{
+		{
+			name:        "full line creates the item right away",
+			input:       "Pay rent #finance -- rent for november",
+			wantCurrent: []string{"Pay rent|rent for november|finance"},
+			wantBoards:  2,
+		},
+		{
+			name:       "board name creates the item in that board",
+			input:      "Pay rent #finance @personal -- rent",
+			wantOther:  []string{"Pay rent|rent|finance"},
+			wantBoards: 2,
+		},
+		{
+			name:        "line without description asks for one",
+			input:       "Pay rent #finance",
+			keys:        []tea.KeyPressMsg{{Code: 'n', Text: "n"}, {Code: tea.KeyEnter}},
+			wantCurrent: []string{"Pay rent|n|finance"},
+			wantBoards:  2,
+		},
+		{
+			name:       "invalid line keeps the input open",
+			input:      "#finance -- rent",
+			wantState:  CreateItemNameState,
+			wantBoards: 2,
+		},
+		{
+			name:       "missing board is created after confirmation",
+			input:      "plan trip @\"Side Projects\" -- summer",
+			keys:       []tea.KeyPressMsg{{Code: 'y', Text: "y"}},
+			wantBoards: 3,
+		},
+		{
+			name:       "declining the missing board discards the item",
+			input:      "plan trip @Travel -- summer",
+			keys:       []tea.KeyPressMsg{{Code: 'n', Text: "n"}},
+			wantBoards: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board, err := svc.CreateBoard(ctx, "Work")
+			require.NoError(t, err)
+			other, err := svc.CreateBoard(ctx, "Personal")
+			require.NoError(t, err)
+			parent := boards.New(ctx, svc)
+			parent.List.SetItems(boards.NewList(&[]service.Board{*board, *other}))
+			parent.List.Select(0)
+			menu := New(ctx, svc, &parent)
+
+			menu.InitCreateItem()
+			menu.Input.SetValue(tt.input)
+			for _, key := range append([]tea.KeyPressMsg{{Code: tea.KeyEnter}}, tt.keys...) {
+				model, cmd := menu.Update(key)
+				menu = model.(MenuModel)
+				if cmd == nil {
+					continue
+				}
+				if msg, ok := cmd().(CreateItemMsg); ok {
+					model, _ = menu.Update(msg)
+					menu = model.(MenuModel)
+				}
+			}
+
+			assert.Equal(t, tt.wantState, menu.Context.State)
+			assert.Len(t, parent.List.Items(), tt.wantBoards)
+			boardList, err := svc.ListBoards(ctx)
+			require.NoError(t, err)
+			assert.Len(t, *boardList, tt.wantBoards)
+
+			summarize := func(items []service.Item) []string {
+				var got []string
+				for _, item := range items {
+					got = append(got, item.Title+"|"+item.Description+"|"+strings.Join(item.Tags, ","))
+				}
+				return got
+			}
+			var shown []service.Item
+			for _, listItem := range menu.List.Items() {
+				shown = append(shown, listItem.(Item).Itm)
+			}
+			assert.Equal(t, tt.wantCurrent, summarize(shown))
+			otherItems, err := svc.ListItemsByBoard(ctx, other)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOther, summarize(*otherItems))
+		})
+	}
+}
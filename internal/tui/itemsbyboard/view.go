@@ -1,6 +1,8 @@
 package itemsbyboard
 
 import (
+	"fmt"
+
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/rhajizada/donezo/internal/tui/styles"
@@ -8,7 +10,32 @@ import (
 
 func (m MenuModel) View() tea.View {
 	content := styles.App.Render(m.List.View().Content)
-	if m.Context.State != DefaultState {
+	switch m.Context.State {
+	case DefaultState:
+	case MoveItemState:
+		content = styles.App.Render(m.Picker.View())
+	case HistoryState:
+		content = styles.App.Render(m.History.View())
+	case BlockersState:
+		content = styles.App.Render(m.Blockers.View())
+	case CreateItemNameState:
+		input := m.Input.View()
+		if preview := m.quickAddPreview(); preview != "" {
+			input += "\n" + preview
+		}
+		content = styles.App.Render(input)
+	case CreateItemBoardState:
+		content = styles.App.Render(
+			styles.StatusMessage.Render(m.Context.Quick.String()) + "\n" +
+				fmt.Sprintf("board \"%s\" does not exist, create it? (y/n)", m.Context.Quick.Board),
+		)
+	case UpdateTagsState:
+		input := m.Input.View()
+		if suggestions := m.Completer.View(); suggestions != "" {
+			input += "\n" + suggestions
+		}
+		content = styles.App.Render(input)
+	default:
 		content = styles.App.Render(m.Input.View())
 	}
 	return tea.NewView(content)
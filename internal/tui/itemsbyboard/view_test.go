@@ -39,6 +39,36 @@ func TestItemsByBoardViewForListAndInputStates(t *testing.T) {
 				assert.Contains(t, view, "new item")
 			},
 		},
+		{
+			name: "create state previews the quick-add line",
+			setup: func(menu *itemsbyboard.MenuModel) {
+				menu.Context.State = itemsbyboard.CreateItemNameState
+				menu.Input.SetValue("Pay rent #finance -- rent")
+			},
+			assertView: func(t *testing.T, view string) {
+				assert.Contains(t, view, `"Pay rent" in "Inbox" #finance -- rent`)
+			},
+		},
+		{
+			name: "create state explains an invalid quick-add line",
+			setup: func(menu *itemsbyboard.MenuModel) {
+				menu.Context.State = itemsbyboard.CreateItemNameState
+				menu.Input.SetValue("task @a @b")
+			},
+			assertView: func(t *testing.T, view string) {
+				assert.Contains(t, view, "more than one board given")
+			},
+		},
+		{
+			name: "missing board asks for confirmation",
+			setup: func(menu *itemsbyboard.MenuModel) {
+				menu.Context.State = itemsbyboard.CreateItemBoardState
+				menu.Context.Quick = service.QuickAdd{Title: "plan trip", Board: "Travel"}
+			},
+			assertView: func(t *testing.T, view string) {
+				assert.Contains(t, view, `board "Travel" does not exist, create it? (y/n)`)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -7,6 +7,11 @@ const (
 	RenameItemNameState
 	RenameItemDescState
 	UpdateTagsState
+	UpdateDueState
+	UpdateRecurrenceState
+	MoveItemState
+	HistoryState
+	BlockersState
 )
 
 type InputContext struct {
@@ -21,7 +21,7 @@ func newItemsByTagMenu(t *testing.T) (MenuModel, func()) {
 
 	board, err := svc.CreateBoard(ctx, "Inbox")
 	require.NoError(t, err)
-	item, err := svc.CreateItem(ctx, board, "task", "desc")
+	item, err := svc.CreateItem(ctx, board, "task", "desc", nil)
 	require.NoError(t, err)
 	item.Tags = []string{"work"}
 	_, err = svc.UpdateItem(ctx, item)
@@ -33,7 +33,7 @@ func newItemsByTagMenu(t *testing.T) (MenuModel, func()) {
 	parent.List.Select(0)
 
 	menu := New(ctx, svc, &parent)
-	menu.List.SetItems(NewList(&[]service.Item{*item}))
+	menu.List.SetItems(NewList(&[]service.Item{*item}, nil))
 	menu.List.Select(0)
 	return menu, cleanup
 }
@@ -71,6 +71,38 @@ func TestItemsByTagKeyBindings(t *testing.T) {
 				assert.Equal(t, UpdateTagsState, menu.Context.State)
 			},
 		},
+		{
+			name: "due enters update due state",
+			msg:  tea.KeyPressMsg{Code: 'D', Text: "D"},
+			assertModel: func(t *testing.T, menu MenuModel) {
+				assert.Equal(t, UpdateDueState, menu.Context.State)
+			},
+		},
+		{
+			name: "recurrence enters update recurrence state",
+			msg:  tea.KeyPressMsg{Code: 'e', Text: "e"},
+			assertModel: func(t *testing.T, menu MenuModel) {
+				assert.Equal(t, UpdateRecurrenceState, menu.Context.State)
+			},
+		},
+		{
+			name: "sort switches to next order",
+			msg:  tea.KeyPressMsg{Code: 's', Text: "s"},
+			assertModel: func(t *testing.T, menu MenuModel) {
+				assert.Equal(t, service.OrderByDueDate, menu.Order)
+			},
+		},
+		{
+			name: "priority key sends update priority message",
+			msg:  tea.KeyPressMsg{Code: 'P', Text: "P"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				msg, ok := cmd().(UpdatePriorityMsg)
+				require.True(t, ok)
+				require.NoError(t, msg.Error)
+				assert.Equal(t, int64(service.PriorityLow), msg.Item.Priority)
+			},
+		},
 		{
 			name: "delete sends delete message",
 			msg:  tea.KeyPressMsg{Code: 'd', Text: "d"},
@@ -1,28 +1,31 @@
 package itemsbytag
 
 import (
-	"strings"
+	"fmt"
 
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/helpers"
 	"github.com/rhajizada/donezo/internal/tui/itemlist"
 )
 
 // Item represents item in the list.
 type Item struct {
-	Itm service.Item
+	Itm  service.Item
+	Meta map[string]service.TagMetadata
 }
 
-func NewList(items *[]service.Item) []itemlist.Item {
+func NewList(items *[]service.Item, meta map[string]service.TagMetadata) []itemlist.Item {
 	l := make([]itemlist.Item, len(*items))
 	for i, item := range *items {
-		l[i] = Item{Itm: item}
+		l[i] = Item{Itm: item, Meta: meta}
 	}
 	return l
 }
 
-func NewItem(item *service.Item) itemlist.Item {
+func NewItem(item *service.Item, meta map[string]service.TagMetadata) itemlist.Item {
 	return Item{
-		Itm: *item,
+		Itm:  *item,
+		Meta: meta,
 	}
 }
 
@@ -30,13 +33,23 @@ func (i Item) Title() string       { return i.Itm.Title }
 func (i Item) Description() string { return i.Itm.Description }
 func (i Item) Footer() string {
 	var message string
+	if due := helpers.FormatDueDate(i.Itm.DueAt); due != "" {
+		message += fmt.Sprintf("Due: %s | ", due)
+	}
+	if i.Itm.Recurrence != "" {
+		message += fmt.Sprintf("Repeats: %s | ", i.Itm.Recurrence)
+	}
 	if len(i.Itm.Tags) > 0 {
 		message += "Tags: "
-		message += strings.Join(i.Itm.Tags, ", ")
+		message += helpers.RenderTags(i.Itm.Tags, i.Meta)
 	} else {
 		message += "No tags"
 	}
+	if i.Itm.SubtasksTotal > 0 {
+		message += fmt.Sprintf(" | %d/%d done", i.Itm.SubtasksDone, i.Itm.SubtasksTotal)
+	}
 	return message
 }
 func (i Item) FilterValue() string { return i.Itm.Title }
 func (i Item) HideValue() bool     { return i.Itm.Completed }
+func (i Item) Blocked() bool       { return i.Itm.BlockedBy > 0 }
@@ -2,9 +2,11 @@ package itemsbytag_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/rhajizada/donezo/internal/repository"
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/tui/itemsbytag"
 )
@@ -13,27 +15,56 @@ func TestItemsByTagItemAccessors(t *testing.T) {
 	tests := []struct {
 		name       string
 		tags       []string
+		due        *time.Time
+		recurrence string
+		blockedBy  int64
+		meta       map[string]service.TagMetadata
 		wantFooter string
 	}{
 		{name: "tags footer renders list", tags: []string{"work", "go"}, wantFooter: "Tags: work, go"},
 		{name: "missing tags footer renders placeholder", tags: nil, wantFooter: "No tags"},
+		{
+			name:       "due date footer renders date before tags",
+			tags:       []string{"work"},
+			due:        new(time.Date(2026, time.March, 14, 0, 0, 0, 0, time.Local)),
+			wantFooter: "Due: 2026-03-14 | Tags: work",
+		},
+		{
+			name:       "recurrence footer renders rule before tags",
+			tags:       []string{"home"},
+			recurrence: "weekly sat",
+			wantFooter: "Repeats: weekly sat | Tags: home",
+		},
+		{name: "blocked item", tags: []string{"work"}, blockedBy: 1, wantFooter: "Tags: work"},
+		{
+			name: "tag metadata is rendered in footer",
+			tags: []string{"work", "go"},
+			meta: map[string]service.TagMetadata{
+				"go": {TagMetadata: repository.TagMetadata{Tag: "go", Emoji: "🐹"}},
+			},
+			wantFooter: "Tags: work, 🐹 go",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			base := service.Item{Item: service.Item{}.Item, Tags: tt.tags}
+			base.DueAt = tt.due
+			base.Recurrence = tt.recurrence
+			base.BlockedBy = tt.blockedBy
 			base.Title = "task"
 			base.Description = "details"
 			base.Completed = true
 
-			item := itemsbytag.NewItem(&base).(itemsbytag.Item)
+			item := itemsbytag.NewItem(&base, tt.meta).(itemsbytag.Item)
 			assert.Equal(t, "task", item.Title())
 			assert.Equal(t, "details", item.Description())
 			assert.Equal(t, "task", item.FilterValue())
 			assert.True(t, item.HideValue())
+			assert.Equal(t, tt.blockedBy > 0, item.Blocked())
 			assert.Equal(t, tt.wantFooter, item.Footer())
 
-			list := itemsbytag.NewList(&[]service.Item{base})
+			list := itemsbytag.NewList(&[]service.Item{base}, nil)
 			assert.Len(t, list, 1)
 		})
 	}
@@ -7,7 +7,8 @@ type ErrorMsg struct {
 }
 
 type ListItemsMsg struct {
-	Items *[]service.Item
+	Items   *[]service.Item
+	TagMeta map[string]service.TagMetadata
 }
 
 type RenameItemMsg struct {
@@ -20,8 +21,36 @@ type UpdateTagsMsg struct {
 	Error error
 }
 
+type UpdateDueMsg struct {
+	Item  *service.Item
+	Error error
+}
+
+type UpdateRecurrenceMsg struct {
+	Item  *service.Item
+	Error error
+}
+
+type UpdatePriorityMsg struct {
+	Item  *service.Item
+	Error error
+}
+
 type ToggleItemMsg struct {
 	Item  *service.Item
+	Next  *service.Item
+	Error error
+}
+
+type UpdateBlockerMsg struct {
+	Blocker  *service.Item
+	Blocking bool
+	Error    error
+}
+
+type MoveItemMsg struct {
+	Item  *service.Item
+	Board *service.Board
 	Error error
 }
 
@@ -7,19 +7,30 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/activity"
+	"github.com/rhajizada/donezo/internal/tui/blockerpicker"
+	"github.com/rhajizada/donezo/internal/tui/boardpicker"
 	"github.com/rhajizada/donezo/internal/tui/itemlist"
+	"github.com/rhajizada/donezo/internal/tui/tagcomplete"
 	"github.com/rhajizada/donezo/internal/tui/tags"
 )
 
 //nolint:recvcheck // Mixed receivers align with tea.Model usage patterns.
 type MenuModel struct {
-	ctx     context.Context
-	Parent  *tags.MenuModel
-	List    itemlist.Model
-	Input   textinput.Model
-	Keys    *Keymap
-	Context *InputContext
-	Service *service.Service
+	ctx       context.Context
+	Parent    *tags.MenuModel
+	Query     string
+	List      itemlist.Model
+	Input     textinput.Model
+	Keys      *Keymap
+	Context   *InputContext
+	Order     service.ItemOrder
+	Picker    boardpicker.Model
+	History   activity.Panel
+	Blockers  blockerpicker.Model
+	Completer tagcomplete.Model
+	TagMeta   map[string]service.TagMetadata
+	Service   *service.Service
 }
 
 func (m MenuModel) Init() tea.Cmd {
@@ -54,3 +65,12 @@ func New(ctx context.Context, service *service.Service, parent *tags.MenuModel)
 		Service: service,
 	}
 }
+
+// NewQuery constructs an items view listing the items that match a boolean
+// tag query instead of the tag selected in parent.
+func NewQuery(ctx context.Context, service *service.Service, parent *tags.MenuModel, query string) MenuModel {
+	m := New(ctx, service, parent)
+	m.Query = query
+	m.List.Title = query
+	return m
+}
@@ -3,11 +3,17 @@ package itemsbytag
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/activity"
+	"github.com/rhajizada/donezo/internal/tui/blockerpicker"
+	"github.com/rhajizada/donezo/internal/tui/boardpicker"
 	"github.com/rhajizada/donezo/internal/tui/helpers"
+	"github.com/rhajizada/donezo/internal/tui/itemlist"
 	"github.com/rhajizada/donezo/internal/tui/styles"
+	"github.com/rhajizada/donezo/internal/tui/tagcomplete"
 	"github.com/rhajizada/donezo/internal/tui/tags"
 
 	tea "charm.land/bubbletea/v2"
@@ -27,20 +33,30 @@ func (m *MenuModel) selectedTag() (tags.Item, bool) {
 	return item, ok
 }
 
-// ListItems fetches items in the selected board.
+// ListItems fetches the items of the selected tag, or of the query when set.
 func (m *MenuModel) ListItems() tea.Cmd {
 	return func() tea.Msg {
-		parentItem, ok := m.selectedTag()
-		if !ok {
-			return ErrorMsg{errors.New("no tag selected")}
+		var items *[]service.Item
+		var err error
+		if m.Query != "" {
+			items, err = m.Service.ListItemsByTagQuery(m.ctx, m.Query)
+		} else {
+			parentItem, ok := m.selectedTag()
+			if !ok {
+				return ErrorMsg{errors.New("no tag selected")}
+			}
+			items, err = m.Service.ListItemsByTag(m.ctx, parentItem.Tag)
 		}
-
-		items, err := m.Service.ListItemsByTag(m.ctx, parentItem.Tag)
+		if err != nil {
+			return ErrorMsg{err}
+		}
+		meta, err := m.Service.ListTagMetadata(m.ctx)
 		if err != nil {
 			return ErrorMsg{err}
 		}
 		return ListItemsMsg{
-			items,
+			Items:   items,
+			TagMeta: meta,
 		}
 	}
 }
@@ -88,6 +104,62 @@ func (m *MenuModel) UpdateTags() tea.Cmd {
 	}
 }
 
+// UpdateDue updates the due date of the selected item.
+func (m *MenuModel) UpdateDue() tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return UpdateDueMsg{Error: errors.New("no item selected")}
+		}
+		due, err := helpers.ParseDueDate(m.Context.Title)
+		if err != nil {
+			return UpdateDueMsg{Error: err}
+		}
+
+		selected.Itm.DueAt = due
+		item, err := m.Service.UpdateItem(m.ctx, &selected.Itm)
+		return UpdateDueMsg{
+			item,
+			err,
+		}
+	}
+}
+
+// UpdateRecurrence updates the recurrence rule of the selected item.
+func (m *MenuModel) UpdateRecurrence() tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return UpdateRecurrenceMsg{Error: errors.New("no item selected")}
+		}
+
+		selected.Itm.Recurrence = m.Context.Title
+		item, err := m.Service.UpdateItem(m.ctx, &selected.Itm)
+		return UpdateRecurrenceMsg{
+			item,
+			err,
+		}
+	}
+}
+
+// SortItems switches to the next sort order and re-sorts the listed items.
+func (m *MenuModel) SortItems() tea.Cmd {
+	m.Order = m.Order.Next()
+	items := make([]service.Item, 0, len(m.List.Items()))
+	for _, listItem := range m.List.Items() {
+		if item, ok := listItem.(Item); ok {
+			items = append(items, item.Itm)
+		}
+	}
+	service.SortItems(items, m.Order)
+	m.List.SetItems(NewList(&items, m.TagMeta))
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("sorted by %s", m.Order),
+		),
+	)
+}
+
 // InitRenameItem starts the renaming process for the selected item.
 func (m *MenuModel) InitRenameItem() tea.Cmd {
 	if len(m.List.Items()) == 0 {
@@ -105,7 +177,7 @@ func (m *MenuModel) InitRenameItem() tea.Cmd {
 	return nil
 }
 
-// InitUpdateTags initializes tag updates.
+// InitUpdateTags initializes tag updates with completion of existing tags.
 func (m *MenuModel) InitUpdateTags() tea.Cmd {
 	m.Context.State = UpdateTagsState
 	m.Input.Placeholder = "Enter comma-separated list of tags"
@@ -116,9 +188,163 @@ func (m *MenuModel) InitUpdateTags() tea.Cmd {
 		m.Input.CursorEnd()
 	}
 	m.Input.Focus()
+
+	completer, err := tagcomplete.Load(m.ctx, m.Service)
+	if err != nil {
+		// Editing still works without suggestions.
+		m.Completer = tagcomplete.New(nil)
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(fmt.Sprintf("failed loading tags: %v", err)),
+		)
+	}
+	m.Completer = completer
+	m.Completer.SetValue(m.Input.Value())
+	return nil
+}
+
+// InitUpdateDue initializes due date updates.
+func (m *MenuModel) InitUpdateDue() tea.Cmd {
+	if len(m.List.Items()) == 0 {
+		return m.List.NewStatusMessage(
+			styles.StatusMessage.Render("no item selected"))
+	}
+
+	m.Context.State = UpdateDueState
+	m.Input.Placeholder = fmt.Sprintf("Enter due date (%s), leave empty to clear", helpers.DueDateLayout)
+	selected, ok := m.selectedItem()
+	if ok {
+		m.Input.SetValue(helpers.FormatDueDate(selected.Itm.DueAt))
+		m.Input.CursorEnd()
+	}
+	m.Input.Focus()
+	return nil
+}
+
+// InitUpdateRecurrence initializes recurrence rule updates.
+func (m *MenuModel) InitUpdateRecurrence() tea.Cmd {
+	if len(m.List.Items()) == 0 {
+		return m.List.NewStatusMessage(
+			styles.StatusMessage.Render("no item selected"))
+	}
+
+	m.Context.State = UpdateRecurrenceState
+	m.Input.Placeholder = "daily, weekly mon,fri, monthly 15 or every 3 days; leave empty to stop repeating"
+	selected, ok := m.selectedItem()
+	if ok {
+		m.Input.SetValue(selected.Itm.Recurrence)
+		m.Input.CursorEnd()
+	}
+	m.Input.Focus()
 	return nil
 }
 
+// InitMoveItem opens the board picker for the selected item.
+func (m *MenuModel) InitMoveItem() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	boardList, err := m.Service.ListBoards(m.ctx)
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{err}
+		}
+	}
+	if len(*boardList) < 2 { //nolint:mnd // the current board and at least one other
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no other board to move to"))
+	}
+
+	m.Picker = boardpicker.New(boardList, selected.Itm.BoardID)
+	m.Picker.SetSize(m.List.Width(), m.List.Height())
+	m.Context.State = MoveItemState
+	return nil
+}
+
+// InitHistory opens the history panel for the selected item.
+func (m *MenuModel) InitHistory() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	entries, err := m.Service.ListActivity(m.ctx, service.ActivityFilter{ItemID: selected.Itm.ID})
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{err}
+		}
+	}
+
+	m.History = activity.NewPanel(&selected.Itm, entries)
+	m.History.SetSize(m.List.Width(), m.List.Height())
+	m.Context.State = HistoryState
+	return nil
+}
+
+// InitBlockers opens the blocker picker for the selected item.
+func (m *MenuModel) InitBlockers() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	picker, err := blockerpicker.Load(m.ctx, m.Service, &selected.Itm)
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{err}
+		}
+	}
+	if len(picker.List.Items()) == 0 {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no other items to depend on"))
+	}
+
+	m.Blockers = picker
+	m.Blockers.SetSize(m.List.Width(), m.List.Height())
+	m.Context.State = BlockersState
+	return nil
+}
+
+// UpdateBlocker adds or removes blocker from the blockers of the selected item.
+func (m *MenuModel) UpdateBlocker(blocker service.Item, blocking bool) tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return UpdateBlockerMsg{Error: errors.New("no item selected")}
+		}
+		var err error
+		if blocking {
+			err = m.Service.AddBlocker(m.ctx, &selected.Itm, &blocker)
+		} else {
+			err = m.Service.RemoveBlocker(m.ctx, &selected.Itm, &blocker)
+		}
+		return UpdateBlockerMsg{
+			Blocker:  &blocker,
+			Blocking: blocking,
+			Error:    err,
+		}
+	}
+}
+
+// MoveItem moves the selected item to board.
+func (m *MenuModel) MoveItem(board service.Board) tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return MoveItemMsg{Error: errors.New("no item selected")}
+		}
+		item, err := m.Service.MoveItem(m.ctx, &selected.Itm, &board)
+		return MoveItemMsg{
+			item,
+			&board,
+			err,
+		}
+	}
+}
+
+func (m *MenuModel) indexOf(id int64) int {
+	return slices.IndexFunc(m.List.Items(), func(i itemlist.Item) bool {
+		item, ok := i.(Item)
+		return ok && item.Itm.ID == id
+	})
+}
+
 // DeleteItem deletes current selected item.
 func (m *MenuModel) DeleteItem() tea.Cmd {
 	return func() tea.Msg {
@@ -142,18 +368,49 @@ func (m MenuModel) ToggleComplete() tea.Cmd {
 	if !ok {
 		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
 	}
+	original := selected.Itm
 	selected.Itm.Completed = !selected.Itm.Completed
 	m.List.SetItem(m.List.Index(), selected)
 
+	return func() tea.Msg {
+		i, next, err := m.Service.ToggleItem(m.ctx, &original)
+		return ToggleItemMsg{Item: i, Next: next, Error: err}
+	}
+}
+
+// CyclePriority moves the selected item to the next priority level.
+func (m MenuModel) CyclePriority() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no item selected"))
+	}
+	selected.Itm.Priority = int64(service.Priority(selected.Itm.Priority).Next())
+	m.List.SetItem(m.List.Index(), selected)
+
 	return func() tea.Msg {
 		i, err := m.Service.UpdateItem(m.ctx, &selected.Itm)
-		return ToggleItemMsg{i, err}
+		return UpdatePriorityMsg{i, err}
 	}
 }
 
 func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if m.Context.State == MoveItemState {
+		cmd := m.HandlePickerState(msg)
+		return m, cmd
+	}
+
+	if m.Context.State == BlockersState {
+		cmd := m.HandleBlockersState(msg)
+		return m, cmd
+	}
+
+	if m.Context.State == HistoryState {
+		cmd := m.HandleHistoryState(msg)
+		return m, cmd
+	}
+
 	if m.Context.State != DefaultState {
 		m.Input, cmds = m.HandleInputState(msg)
 		return m, tea.Batch(cmds...)
@@ -173,7 +430,9 @@ func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 
 	case ListItemsMsg:
-		m.List.SetItems(NewList(msg.Items))
+		service.SortItems(*msg.Items, m.Order)
+		m.TagMeta = msg.TagMeta
+		m.List.SetItems(NewList(msg.Items, m.TagMeta))
 
 	case DeleteItemMsg:
 		cmd := m.HandleDeleteItem(msg)
@@ -189,9 +448,25 @@ func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := m.HandleUpdateTags(msg)
 		cmds = append(cmds, cmd)
 
+	case UpdateDueMsg:
+		cmd := m.HandleUpdateDue(msg)
+		cmds = append(cmds, cmd)
+
+	case UpdateRecurrenceMsg:
+		cmd := m.HandleUpdateRecurrence(msg)
+		cmds = append(cmds, cmd)
+
+	case UpdatePriorityMsg:
+		cmd := m.HandleUpdatePriority(msg)
+		cmds = append(cmds, cmd)
+
 	case ToggleItemMsg:
 		cmd := m.HandleToggleItem(msg)
 		cmds = append(cmds, cmd)
+
+	case MoveItemMsg:
+		cmd := m.HandleMoveItem(msg)
+		cmds = append(cmds, cmd)
 	}
 
 	listModel, listCmd := m.List.Update(msg)
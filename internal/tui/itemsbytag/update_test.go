@@ -9,6 +9,7 @@ import (
 
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/boardpicker"
 	"github.com/rhajizada/donezo/internal/tui/itemsbytag"
 	"github.com/rhajizada/donezo/internal/tui/tags"
 )
@@ -20,7 +21,7 @@ func newItemsByTagMenu(t *testing.T) (itemsbytag.MenuModel, func()) {
 
 	board, err := svc.CreateBoard(ctx, "Inbox")
 	require.NoError(t, err)
-	item, err := svc.CreateItem(ctx, board, "task", "desc")
+	item, err := svc.CreateItem(ctx, board, "task", "desc", nil)
 	require.NoError(t, err)
 	item.Tags = []string{"work"}
 	item, err = svc.UpdateItem(ctx, item)
@@ -33,7 +34,7 @@ func newItemsByTagMenu(t *testing.T) (itemsbytag.MenuModel, func()) {
 	parent.List.Select(0)
 
 	menu := itemsbytag.New(ctx, svc, &parent)
-	menu.List.SetItems(itemsbytag.NewList(&[]service.Item{*item}))
+	menu.List.SetItems(itemsbytag.NewList(&[]service.Item{*item}, nil))
 	menu.List.Select(0)
 	return menu, cleanup
 }
@@ -208,3 +209,55 @@ func TestUpdateWithListItemsMsgReplacesList(t *testing.T) {
 		})
 	}
 }
+
+func TestMoveItemKeepsItemInTagView(t *testing.T) {
+	menu, cleanup := newItemsByTagMenu(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	target, err := menu.Service.CreateBoard(ctx, "Target")
+	require.NoError(t, err)
+
+	model, _ := menu.Update(tea.KeyPressMsg{Code: 'm', Text: "m"})
+	menu = model.(itemsbytag.MenuModel)
+	require.Equal(t, itemsbytag.MoveItemState, menu.Context.State)
+
+	model, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	menu = model.(itemsbytag.MenuModel)
+	require.NotNil(t, cmd)
+	picked, ok := cmd().(boardpicker.PickedMsg)
+	require.True(t, ok)
+	assert.Equal(t, target.ID, picked.Board.ID)
+
+	model, cmd = menu.Update(picked)
+	menu = model.(itemsbytag.MenuModel)
+	require.NotNil(t, cmd)
+	moved, ok := cmd().(itemsbytag.MoveItemMsg)
+	require.True(t, ok)
+	require.NoError(t, moved.Error)
+
+	model, _ = menu.Update(moved)
+	menu = model.(itemsbytag.MenuModel)
+	assert.Equal(t, itemsbytag.DefaultState, menu.Context.State)
+	require.Len(t, menu.List.Items(), 1)
+	assert.Equal(t, target.ID, menu.List.Items()[0].(itemsbytag.Item).Itm.BoardID)
+}
+
+func TestItemHistoryPanel(t *testing.T) {
+	menu, cleanup := newItemsByTagMenu(t)
+	defer cleanup()
+
+	model, _ := menu.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	menu = model.(itemsbytag.MenuModel)
+	model, _ = menu.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	menu = model.(itemsbytag.MenuModel)
+	require.Equal(t, itemsbytag.HistoryState, menu.Context.State)
+	assert.Contains(t, menu.View().Content, `tagged "task" with "work"`)
+
+	model, cmd := menu.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	menu = model.(itemsbytag.MenuModel)
+	require.NotNil(t, cmd)
+	model, _ = menu.Update(cmd())
+	menu = model.(itemsbytag.MenuModel)
+	assert.Equal(t, itemsbytag.DefaultState, menu.Context.State)
+}
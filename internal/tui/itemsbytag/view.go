@@ -8,7 +8,21 @@ import (
 
 func (m MenuModel) View() tea.View {
 	content := styles.App.Render(m.List.View().Content)
-	if m.Context.State != DefaultState {
+	switch m.Context.State {
+	case DefaultState:
+	case MoveItemState:
+		content = styles.App.Render(m.Picker.View())
+	case HistoryState:
+		content = styles.App.Render(m.History.View())
+	case BlockersState:
+		content = styles.App.Render(m.Blockers.View())
+	case UpdateTagsState:
+		input := m.Input.View()
+		if suggestions := m.Completer.View(); suggestions != "" {
+			input += "\n" + suggestions
+		}
+		content = styles.App.Render(input)
+	default:
 		content = styles.App.Render(m.Input.View())
 	}
 	return tea.NewView(content)
@@ -48,7 +48,7 @@ func TestItemsByTagViewForListAndInputStates(t *testing.T) {
 			ctx := testutil.MustContext()
 			board, err := svc.CreateBoard(ctx, "Inbox")
 			require.NoError(t, err)
-			item, err := svc.CreateItem(ctx, board, "task", "desc")
+			item, err := svc.CreateItem(ctx, board, "task", "desc", nil)
 			require.NoError(t, err)
 			item.Tags = []string{"work"}
 			_, err = svc.UpdateItem(ctx, item)
@@ -61,7 +61,7 @@ func TestItemsByTagViewForListAndInputStates(t *testing.T) {
 			parent.List.Select(0)
 
 			menu := itemsbytag.New(ctx, svc, &parent)
-			menu.List.SetItems(itemsbytag.NewList(&[]service.Item{*item}))
+			menu.List.SetItems(itemsbytag.NewList(&[]service.Item{*item}, nil))
 			menu.List.Select(0)
 			tt.setup(&menu)
 			tt.assertView(t, menu.View().Content)
@@ -0,0 +1,166 @@
+// Package keybindings applies user overrides to the keymaps of the TUI views
+// and finds keys bound to more than one action.
+package keybindings
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"charm.land/bubbles/v2/key"
+)
+
+// Overrides maps the actions of a view, such as "createBoard", to the keys
+// that trigger them. An empty list unbinds the action.
+type Overrides map[string][]string
+
+//nolint:gochecknoglobals // set once at startup, read by every NewKeymap
+var overrides = map[string]Overrides{}
+
+// Configure sets the overrides Apply uses, by view name. Passing nil restores
+// the default keymaps.
+func Configure(views map[string]Overrides) {
+	overrides = map[string]Overrides{}
+	for view, actions := range views {
+		overrides[view] = actions
+	}
+}
+
+// Keymap is the keymap of a view: a pointer to a struct whose key.Binding
+// fields are the actions of the view.
+type Keymap struct {
+	View     string
+	Bindings any
+}
+
+// Apply replaces the keys of the actions of keymap that are overridden for
+// view. keymap must be a pointer to a struct of key.Binding fields.
+func Apply(view string, keymap any) {
+	for action, keys := range overrides[view] {
+		binding := lookup(keymap, action)
+		if binding == nil {
+			continue
+		}
+		if len(keys) == 0 {
+			binding.SetEnabled(false)
+			continue
+		}
+		binding.SetKeys(keys...)
+		binding.SetHelp(strings.Join(keys, "/"), binding.Help().Desc)
+	}
+}
+
+// Actions returns the names of the actions of keymap in declaration order.
+func Actions(keymap any) []string {
+	var actions []string
+	each(keymap, func(action string, _ *key.Binding) {
+		actions = append(actions, action)
+	})
+	return actions
+}
+
+func lookup(keymap any, action string) *key.Binding {
+	var found *key.Binding
+	each(keymap, func(name string, binding *key.Binding) {
+		if name == action {
+			found = binding
+		}
+	})
+	return found
+}
+
+// each calls fn with every key.Binding field of keymap, named after the field
+// in lower camel case.
+func each(keymap any, fn func(action string, binding *key.Binding)) {
+	v := reflect.ValueOf(keymap)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	v = v.Elem()
+	for i := range v.NumField() {
+		binding, ok := v.Field(i).Addr().Interface().(*key.Binding)
+		if !ok || !v.Type().Field(i).IsExported() {
+			continue
+		}
+		name := []rune(v.Type().Field(i).Name)
+		name[0] = unicode.ToLower(name[0])
+		fn(string(name), binding)
+	}
+}
+
+// Conflict is a key bound to several actions of the same view.
+type Conflict struct {
+	View    string
+	Key     string
+	Actions []string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: %q is bound to %s", c.View, c.Key, strings.Join(c.Actions, " and "))
+}
+
+// Overlap is a pair of actions allowed to share keys because they are never
+// active at the same time, such as opening and closing the help.
+type Overlap [2]string
+
+func (o Overlap) allows(a, b string) bool {
+	return (o[0] == a && o[1] == b) || (o[0] == b && o[1] == a)
+}
+
+// Conflicts returns the keys bound to more than one action of the keymaps
+// active in view, sorted by key, whether the keys are defaults or overrides.
+// A key is not reported when every pair of its actions is in allowed, which
+// names actions without their view. Conflicts among the other keymaps alone
+// are left to their own views.
+func Conflicts(view string, allowed []Overlap, keymaps ...Keymap) []Conflict {
+	type action struct {
+		name string
+		own  bool
+		// bare is the name without the view, as used by allowed.
+		bare string
+	}
+	byKey := map[string][]action{}
+	for _, km := range keymaps {
+		each(km.Bindings, func(name string, binding *key.Binding) {
+			if !binding.Enabled() {
+				return
+			}
+			a := action{name: name, own: km.View == view, bare: name}
+			if !a.own {
+				a.name = km.View + "." + name
+			}
+			for _, k := range binding.Keys() {
+				byKey[k] = append(byKey[k], a)
+			}
+		})
+	}
+
+	var conflicts []Conflict
+	for k, actions := range byKey {
+		if !slices.ContainsFunc(actions, func(a action) bool { return a.own }) {
+			continue
+		}
+		clash := false
+		for i := range actions {
+			for _, other := range actions[i+1:] {
+				if !slices.ContainsFunc(allowed, func(o Overlap) bool { return o.allows(actions[i].bare, other.bare) }) {
+					clash = true
+				}
+			}
+		}
+		if !clash {
+			continue
+		}
+		names := make([]string, len(actions))
+		for i, a := range actions {
+			names[i] = a.name
+		}
+		conflicts = append(conflicts, Conflict{View: view, Key: k, Actions: names})
+	}
+	slices.SortFunc(conflicts, func(a, b Conflict) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return conflicts
+}
@@ -0,0 +1,97 @@
+package keybindings_test
+
+import (
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+)
+
+type testKeymap struct {
+	Create key.Binding
+	Delete key.Binding
+	Help   key.Binding
+	Close  key.Binding
+}
+
+func newTestKeymap() testKeymap {
+	km := testKeymap{
+		Create: key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "create")),
+		Delete: key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "delete")),
+		Help:   key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "help")),
+		Close:  key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "close help")),
+	}
+	keybindings.Apply("test", &km)
+	return km
+}
+
+func TestApply(t *testing.T) {
+	keybindings.Configure(map[string]keybindings.Overrides{
+		"test":  {"create": {"n", "ctrl+n"}, "delete": {}},
+		"other": {"help": {"h"}},
+	})
+	t.Cleanup(func() { keybindings.Configure(nil) })
+
+	km := newTestKeymap()
+	assert.Equal(t, []string{"n", "ctrl+n"}, km.Create.Keys())
+	assert.Equal(t, key.Help{Key: "n/ctrl+n", Desc: "create"}, km.Create.Help())
+	assert.False(t, km.Delete.Enabled())
+	assert.Equal(t, []string{"?"}, km.Help.Keys())
+}
+
+func TestActions(t *testing.T) {
+	km := newTestKeymap()
+	assert.Equal(t, []string{"create", "delete", "help", "close"}, keybindings.Actions(&km))
+}
+
+func TestConflicts(t *testing.T) {
+	other := testKeymap{Delete: key.NewBinding(key.WithKeys("x"))}
+	helpToggle := []keybindings.Overlap{{"close", "help"}}
+	tests := []struct {
+		name      string
+		overrides map[string]keybindings.Overrides
+		allowed   []keybindings.Overlap
+		want      []string
+	}{
+		{name: "allowed overlaps are not reported", allowed: helpToggle},
+		{name: "clashing defaults are reported", want: []string{`test: "?" is bound to help and close`}},
+		{
+			name:      "override clashing with another action",
+			overrides: map[string]keybindings.Overrides{"test": {"create": {"d"}}},
+			allowed:   helpToggle,
+			want:      []string{`test: "d" is bound to create and delete`},
+		},
+		{
+			name:      "override clashing with a keymap active in the view",
+			overrides: map[string]keybindings.Overrides{"test": {"create": {"n", "x"}}},
+			allowed:   helpToggle,
+			want:      []string{`test: "x" is bound to create and other.delete`},
+		},
+		{
+			name:      "unbound actions do not conflict",
+			overrides: map[string]keybindings.Overrides{"test": {"create": {"d"}, "delete": {}}},
+			allowed:   helpToggle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keybindings.Configure(tt.overrides)
+			t.Cleanup(func() { keybindings.Configure(nil) })
+
+			km := newTestKeymap()
+			var got []string
+			for _, c := range keybindings.Conflicts(
+				"test",
+				tt.allowed,
+				keybindings.Keymap{View: "test", Bindings: &km},
+				keybindings.Keymap{View: "other", Bindings: &other},
+			) {
+				got = append(got, c.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
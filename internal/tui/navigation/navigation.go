@@ -8,6 +8,9 @@ const (
 	ViewTags
 	ViewItemsByBoard
 	ViewItemsByTag
+	ViewSubtasks
+	ViewTrash
+	ViewActivity
 )
 
 // SwitchMainViewMsg requests swapping between the root menus (boards <-> tags).
@@ -21,6 +24,21 @@ type OpenBoardItemsMsg struct{}
 // OpenTagItemsMsg requests opening the items view for the selected tag.
 type OpenTagItemsMsg struct{}
 
+// OpenTagQueryMsg requests opening the items view for the items matching a
+// boolean tag query.
+type OpenTagQueryMsg struct {
+	Query string
+}
+
+// OpenSubtasksMsg requests opening the checklist of the selected board item.
+type OpenSubtasksMsg struct{}
+
+// OpenTrashMsg requests opening the trash with deleted boards and items.
+type OpenTrashMsg struct{}
+
+// OpenActivityMsg requests opening the activity feed.
+type OpenActivityMsg struct{}
+
 // BackMsg requests returning to the previous view (from detail to its parent menu).
 type BackMsg struct{}
 
@@ -16,6 +16,9 @@ func TestViewOrdering(t *testing.T) {
 		{name: "tags view", view: ViewTags, want: 1},
 		{name: "items by board view", view: ViewItemsByBoard, want: 2},
 		{name: "items by tag view", view: ViewItemsByTag, want: 3},
+		{name: "subtasks view", view: ViewSubtasks, want: 4},
+		{name: "trash view", view: ViewTrash, want: 5},
+		{name: "activity view", view: ViewActivity, want: 6},
 	}
 
 	for _, tt := range tests {
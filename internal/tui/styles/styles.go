@@ -22,11 +22,74 @@ var (
 	ErrorMessage = lipgloss.NewStyle().
 			Foreground(compat.AdaptiveColor{Light: lipgloss.Color("#FB4A8A"), Dark: lipgloss.Color("#FB4A8A")})
 
+	Overdue = lipgloss.NewStyle().
+		Foreground(compat.AdaptiveColor{Light: lipgloss.Color("#FB4A8A"), Dark: lipgloss.Color("#FB4A8A")})
+
+	DueToday = lipgloss.NewStyle().
+			Foreground(compat.AdaptiveColor{Light: lipgloss.Color("#D98E04"), Dark: lipgloss.Color("#F5B041")})
+
 	Item = lipgloss.NewStyle().
 		Padding(0, 0)
 
+	Overlay = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#7D56F4")).
+		Padding(0, 1)
+
+	TagChip = lipgloss.NewStyle().
+		Padding(0, 1).
+		Foreground(lipgloss.Color("#FFFDF5"))
+
+	Suggestion = lipgloss.NewStyle().
+			Foreground(compat.AdaptiveColor{Light: lipgloss.Color("#A49FA5"), Dark: lipgloss.Color("#777777")})
+
+	SelectedSuggestion = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#7D56F4"))
+
 	Footer = lipgloss.NewStyle().
 		Margin(0, footerMargin).
 		Bold(true).
 		Foreground(lipgloss.Color("#7D56F4"))
 )
+
+// Palette overrides the colors of the TUI. Colors are hex values such as
+// "#7D56F4" or ANSI color numbers; empty fields keep the default color.
+type Palette struct {
+	// Accent colors overlay borders, the selected suggestion and footers.
+	Accent string
+	// Status colors status messages.
+	Status string
+	// Error colors error messages.
+	Error string
+	// Overdue colors the due date of overdue items.
+	Overdue string
+	// DueToday colors the due date of items due today.
+	DueToday string
+	// Muted colors tag suggestions.
+	Muted string
+}
+
+// SetPalette applies the non-empty colors of p to the shared styles.
+func SetPalette(p Palette) {
+	if p.Accent != "" {
+		accent := lipgloss.Color(p.Accent)
+		Overlay = Overlay.BorderForeground(accent)
+		SelectedSuggestion = SelectedSuggestion.Foreground(accent)
+		Footer = Footer.Foreground(accent)
+	}
+	if p.Status != "" {
+		StatusMessage = StatusMessage.Foreground(lipgloss.Color(p.Status))
+	}
+	if p.Error != "" {
+		ErrorMessage = ErrorMessage.Foreground(lipgloss.Color(p.Error))
+	}
+	if p.Overdue != "" {
+		Overdue = Overdue.Foreground(lipgloss.Color(p.Overdue))
+	}
+	if p.DueToday != "" {
+		DueToday = DueToday.Foreground(lipgloss.Color(p.DueToday))
+	}
+	if p.Muted != "" {
+		Suggestion = Suggestion.Foreground(lipgloss.Color(p.Muted))
+	}
+}
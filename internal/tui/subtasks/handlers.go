@@ -0,0 +1,157 @@
+package subtasks
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rhajizada/donezo/internal/tui/navigation"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+// HandleWindowSize processes window size messages.
+func (m *MenuModel) HandleWindowSize(msg tea.WindowSizeMsg) tea.Cmd {
+	h, v := styles.App.GetFrameSize()
+	m.List.SetSize(msg.Width-h, msg.Height-v)
+	m.Input.SetWidth(msg.Width - h)
+	return nil
+}
+
+// HandleError  processes errors and displays error messages.
+func (m *MenuModel) HandleError(msg ErrorMsg) tea.Cmd {
+	formattedMsg := fmt.Sprintf("error: %v", msg.Error)
+	return m.List.NewStatusMessage(
+		styles.ErrorMessage.Render(formattedMsg),
+	)
+}
+
+// HandleAddSubtask handles AddSubtaskMsg.
+func (m *MenuModel) HandleAddSubtask(msg AddSubtaskMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("error adding subtask: %v", msg.Error),
+			),
+		)
+	}
+	m.List.InsertItem(len(m.List.Items()), NewItem(msg.Subtask))
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("added subtask \"%s\"", msg.Subtask.Title),
+		),
+	)
+}
+
+// HandleUpdateSubtask handles UpdateSubtaskMsg.
+func (m *MenuModel) HandleUpdateSubtask(msg UpdateSubtaskMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed updating subtask: %v", msg.Error),
+			),
+		)
+	}
+
+	m.List.SetItem(m.List.Index(), NewItem(msg.Subtask))
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("updated subtask \"%s\"", msg.Subtask.Title),
+		),
+	)
+}
+
+// HandleMoveSubtask handles MoveSubtaskMsg.
+func (m *MenuModel) HandleMoveSubtask(msg MoveSubtaskMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed moving subtask: %v", msg.Error),
+			),
+		)
+	}
+
+	cmd := m.List.SetItems(NewList(msg.Subtasks))
+	m.List.Select(msg.Index)
+	return cmd
+}
+
+// HandleDeleteSubtask handles DeleteSubtaskMsg.
+func (m *MenuModel) HandleDeleteSubtask(msg DeleteSubtaskMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed deleting subtask: %v", msg.Error),
+			),
+		)
+	}
+
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("deleted subtask \"%s\"", msg.Subtask.Title),
+		),
+	)
+}
+
+// HandleInputState handles AddSubtaskState and RenameSubtaskState states.
+func (m *MenuModel) HandleInputState(msg tea.Msg) (textinput.Model, []tea.Cmd) {
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	m.Input, cmd = m.Input.Update(msg)
+	cmds = append(cmds, cmd)
+
+	// Only handle key messages in input states
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch keyMsg.Code {
+		case tea.KeyEnter:
+			switch m.State {
+			case AddSubtaskState:
+				cmds = append(cmds, m.AddSubtask())
+				m.State = DefaultState
+				m.Input.Blur()
+			case RenameSubtaskState:
+				cmds = append(cmds, m.RenameSubtask())
+				m.State = DefaultState
+				m.Input.Blur()
+			case DefaultState:
+				// no-op
+			}
+		case tea.KeyEsc:
+			// Cancel the current operation
+			m.State = DefaultState
+			m.Input.Blur()
+		default:
+			// ignore other key types
+		}
+	}
+
+	return m.Input, cmds
+}
+
+// HandleKeyInput processes key inputs not handles by list.Model.
+func (m *MenuModel) HandleKeyInput(msg tea.KeyPressMsg) tea.Cmd {
+	var cmd tea.Cmd
+	if !m.List.SettingFilter() && m.State == DefaultState {
+		switch {
+		case key.Matches(msg, m.Keys.AddSubtask):
+			cmd = m.InitAddSubtask()
+		case key.Matches(msg, m.Keys.RenameSubtask):
+			cmd = m.InitRenameSubtask()
+		case key.Matches(msg, m.Keys.DeleteSubtask):
+			cmd = m.DeleteSubtask()
+		case key.Matches(msg, m.Keys.Toggle):
+			cmd = m.ToggleSubtask()
+		case key.Matches(msg, m.Keys.MoveUp):
+			cmd = m.MoveSubtask(-1)
+		case key.Matches(msg, m.Keys.MoveDown):
+			cmd = m.MoveSubtask(1)
+		case key.Matches(msg, m.Keys.RefreshList):
+			cmd = m.ListSubtasks()
+		case key.Matches(msg, m.Keys.Back):
+			cmd = func() tea.Msg { return navigation.BackMsg{} }
+		}
+	}
+	return cmd
+}
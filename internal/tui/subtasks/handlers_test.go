@@ -0,0 +1,146 @@
+package subtasks
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/navigation"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+func newSubtaskMenu(t *testing.T) (MenuModel, func()) {
+	t.Helper()
+	svc, cleanup := testutil.NewTestService(t)
+	ctx := testutil.MustContext()
+	board, err := svc.CreateBoard(ctx, "Inbox")
+	require.NoError(t, err)
+	item, err := svc.CreateItem(ctx, board, "project", "", nil)
+	require.NoError(t, err)
+	for _, title := range []string{"one", "two"} {
+		_, err = svc.AddSubtask(ctx, item, title)
+		require.NoError(t, err)
+	}
+	subtasks, err := svc.ListSubtasks(ctx, item)
+	require.NoError(t, err)
+	menu := New(ctx, svc, item)
+	menu.List.SetItems(NewList(subtasks))
+	menu.List.Select(0)
+	return menu, cleanup
+}
+
+func TestSubtasksKeyBindings(t *testing.T) {
+	t.Run("window resize updates input width", func(t *testing.T) {
+		menu, cleanup := newSubtaskMenu(t)
+		defer cleanup()
+
+		width := 120
+		model, _ := menu.Update(tea.WindowSizeMsg{Width: width, Height: 40})
+		menu = model.(MenuModel)
+
+		h, _ := styles.App.GetFrameSize()
+		assert.Equal(t, width-h, menu.Input.Width())
+	})
+
+	tests := []struct {
+		name        string
+		msg         tea.KeyPressMsg
+		assertModel func(*testing.T, MenuModel)
+		assertCmd   func(*testing.T, tea.Cmd)
+	}{
+		{
+			name: "add enters add state",
+			msg:  tea.KeyPressMsg{Code: 'a', Text: "a"},
+			assertModel: func(t *testing.T, menu MenuModel) {
+				assert.Equal(t, AddSubtaskState, menu.State)
+			},
+		},
+		{
+			name: "rename enters rename state",
+			msg:  tea.KeyPressMsg{Code: 'r', Text: "r"},
+			assertModel: func(t *testing.T, menu MenuModel) {
+				assert.Equal(t, RenameSubtaskState, menu.State)
+				assert.Equal(t, "one", menu.Input.Value())
+			},
+		},
+		{
+			name: "toggle sends update message",
+			msg:  tea.KeyPressMsg{Code: tea.KeySpace, Text: " "},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				msg, ok := cmd().(UpdateSubtaskMsg)
+				require.True(t, ok)
+				require.NoError(t, msg.Error)
+				assert.True(t, msg.Subtask.Completed)
+			},
+		},
+		{
+			name: "move down sends move message",
+			msg:  tea.KeyPressMsg{Code: 'J', Text: "J"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				msg, ok := cmd().(MoveSubtaskMsg)
+				require.True(t, ok)
+				require.NoError(t, msg.Error)
+				assert.Equal(t, 1, msg.Index)
+				assert.Equal(t, "one", (*msg.Subtasks)[1].Title)
+			},
+		},
+		{
+			name: "move up on first subtask is a no-op",
+			msg:  tea.KeyPressMsg{Code: 'K', Text: "K"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				if cmd == nil {
+					return
+				}
+				_, ok := cmd().(MoveSubtaskMsg)
+				assert.False(t, ok)
+			},
+		},
+		{
+			name: "delete sends delete message",
+			msg:  tea.KeyPressMsg{Code: 'd', Text: "d"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				_, ok := cmd().(DeleteSubtaskMsg)
+				assert.True(t, ok)
+			},
+		},
+		{
+			name: "refresh sends list message",
+			msg:  tea.KeyPressMsg{Code: 'R', Text: "R"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				_, ok := cmd().(ListSubtasksMsg)
+				assert.True(t, ok)
+			},
+		},
+		{
+			name: "backspace navigates back",
+			msg:  tea.KeyPressMsg{Code: tea.KeyBackspace},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				assert.Equal(t, navigation.BackMsg{}, cmd())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			menu, cleanup := newSubtaskMenu(t)
+			defer cleanup()
+
+			model, cmd := menu.Update(tt.msg)
+			menu = model.(MenuModel)
+			if tt.assertModel != nil {
+				tt.assertModel(t, menu)
+			}
+			if tt.assertCmd != nil {
+				tt.assertCmd(t, cmd)
+			}
+		})
+	}
+}
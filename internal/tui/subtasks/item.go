@@ -0,0 +1,36 @@
+package subtasks
+
+import (
+	"charm.land/bubbles/v2/list"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+// Item represents subtask in the list.
+type Item struct {
+	Subtask service.Subtask
+}
+
+func NewList(subtasks *[]service.Subtask) []list.Item {
+	l := make([]list.Item, len(*subtasks))
+	for i, subtask := range *subtasks {
+		l[i] = Item{Subtask: subtask}
+	}
+	return l
+}
+
+func NewItem(subtask *service.Subtask) list.Item {
+	return Item{
+		Subtask: *subtask,
+	}
+}
+
+func (i Item) Title() string {
+	box := "[ ]"
+	if i.Subtask.Completed {
+		box = "[x]"
+	}
+	return box + " " + i.Subtask.Title
+}
+func (i Item) Description() string { return i.Subtask.CreatedAt.Format("01-02-2006 15:04") }
+func (i Item) FilterValue() string { return i.Subtask.Title }
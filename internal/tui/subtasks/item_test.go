@@ -0,0 +1,35 @@
+package subtasks_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/subtasks"
+)
+
+func TestSubtaskItemAccessors(t *testing.T) {
+	tests := []struct {
+		name      string
+		completed bool
+		wantTitle string
+	}{
+		{name: "open subtask", completed: false, wantTitle: "[ ] step"},
+		{name: "completed subtask", completed: true, wantTitle: "[x] step"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subtask := service.Subtask{Subtask: repository.Subtask{Title: "step", Completed: tt.completed}}
+
+			item := subtasks.NewItem(&subtask).(subtasks.Item)
+			assert.Equal(t, tt.wantTitle, item.Title())
+			assert.Equal(t, "step", item.FilterValue())
+
+			list := subtasks.NewList(&[]service.Subtask{subtask})
+			assert.Len(t, list, 1)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package subtasks
+
+import (
+	"charm.land/bubbles/v2/key"
+
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+)
+
+// Keymap embeds default list keymap and adds other Binding.
+type Keymap struct {
+	Back          key.Binding
+	AddSubtask    key.Binding
+	RenameSubtask key.Binding
+	DeleteSubtask key.Binding
+	Toggle        key.Binding
+	MoveUp        key.Binding
+	MoveDown      key.Binding
+	RefreshList   key.Binding
+}
+
+func NewKeymap() Keymap {
+	km := Keymap{
+		Back: key.NewBinding(
+			key.WithKeys("backspace"),
+			key.WithHelp("backspace", "back"),
+		),
+		AddSubtask: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "add subtask"),
+		),
+		RenameSubtask: key.NewBinding(key.WithKeys("r"),
+			key.WithHelp("r", "rename subtask"),
+		),
+		DeleteSubtask: key.NewBinding(key.WithKeys("d"),
+			key.WithHelp("d", "delete subtask"),
+		),
+		Toggle: key.NewBinding(key.WithKeys("space"),
+			key.WithHelp("space", "toggle done"),
+		),
+		MoveUp: key.NewBinding(key.WithKeys("K"),
+			key.WithHelp("K", "move up"),
+		),
+		MoveDown: key.NewBinding(key.WithKeys("J"),
+			key.WithHelp("J", "move down"),
+		),
+		RefreshList: key.NewBinding(key.WithKeys("R"),
+			key.WithHelp("R", "refresh list"),
+		),
+	}
+	keybindings.Apply("subtasks", &km)
+	return km
+}
+
+func (km Keymap) ShortHelp() []key.Binding {
+	bindings := []key.Binding{}
+	bindings = append(bindings, km.Back)
+	bindings = append(bindings, km.AddSubtask)
+	bindings = append(bindings, km.Toggle)
+	return bindings
+}
+
+func (km Keymap) FullHelp() []key.Binding {
+	bindings := []key.Binding{}
+	bindings = append(bindings, km.Back)
+	bindings = append(bindings, km.AddSubtask)
+	bindings = append(bindings, km.RenameSubtask)
+	bindings = append(bindings, km.DeleteSubtask)
+	bindings = append(bindings, km.Toggle)
+	bindings = append(bindings, km.MoveUp)
+	bindings = append(bindings, km.MoveDown)
+	bindings = append(bindings, km.RefreshList)
+	return bindings
+}
@@ -0,0 +1,32 @@
+package subtasks
+
+import "github.com/rhajizada/donezo/internal/service"
+
+type ErrorMsg struct {
+	Error error
+}
+
+type ListSubtasksMsg struct {
+	Subtasks *[]service.Subtask
+}
+
+type AddSubtaskMsg struct {
+	Subtask *service.Subtask
+	Error   error
+}
+
+type UpdateSubtaskMsg struct {
+	Subtask *service.Subtask
+	Error   error
+}
+
+type MoveSubtaskMsg struct {
+	Subtasks *[]service.Subtask
+	Index    int
+	Error    error
+}
+
+type DeleteSubtaskMsg struct {
+	Subtask *service.Subtask
+	Error   error
+}
@@ -0,0 +1,56 @@
+package subtasks
+
+import (
+	"context"
+	"fmt"
+
+	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/itemlist"
+)
+
+//nolint:recvcheck // Bubble Tea models intentionally mix value/pointer receivers for tea.Model interface.
+type MenuModel struct {
+	ctx    context.Context
+	Item   service.Item
+	List   list.Model
+	Input  textinput.Model
+	Keys   *Keymap
+	State  InputState
+	Client *service.Service
+}
+
+func (m MenuModel) Init() tea.Cmd {
+	return m.ListSubtasks()
+}
+
+// New constructs the checklist editor for item.
+func New(ctx context.Context, client *service.Service, item *service.Item) MenuModel {
+	delegate := list.NewDefaultDelegate()
+	delegate.ShowDescription = false
+	list := list.New(
+		[]list.Item{},
+		delegate,
+		0,
+		0,
+	)
+	input := textinput.New()
+	keymap := NewKeymap()
+	list.KeyMap = itemlist.DefaultKeyMap().List()
+	list.Title = fmt.Sprintf("%s | Checklist", item.Title)
+	list.SetStatusBarItemName("subtask", "subtasks")
+	list.AdditionalShortHelpKeys = keymap.ShortHelp
+	list.AdditionalFullHelpKeys = keymap.FullHelp
+	return MenuModel{
+		ctx:    ctx,
+		Item:   *item,
+		List:   list,
+		Input:  input,
+		Keys:   &keymap,
+		State:  DefaultState,
+		Client: client,
+	}
+}
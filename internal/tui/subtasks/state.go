@@ -0,0 +1,9 @@
+package subtasks
+
+type InputState uint8
+
+const (
+	DefaultState InputState = iota
+	AddSubtaskState
+	RenameSubtaskState
+)
@@ -0,0 +1,177 @@
+package subtasks
+
+import (
+	"errors"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+func (m *MenuModel) selectedItem() (Item, bool) {
+	item, ok := m.List.SelectedItem().(Item)
+	return item, ok
+}
+
+// ListSubtasks fetches the checklist of the item.
+func (m *MenuModel) ListSubtasks() tea.Cmd {
+	return func() tea.Msg {
+		subtasks, err := m.Client.ListSubtasks(m.ctx, &m.Item)
+		if err != nil {
+			return ErrorMsg{err}
+		}
+		return ListSubtasksMsg{
+			subtasks,
+		}
+	}
+}
+
+// AddSubtask appends a new subtask to the checklist.
+func (m *MenuModel) AddSubtask() tea.Cmd {
+	return func() tea.Msg {
+		subtask, err := m.Client.AddSubtask(m.ctx, &m.Item, m.Input.Value())
+		return AddSubtaskMsg{
+			subtask,
+			err,
+		}
+	}
+}
+
+// RenameSubtask renames selected subtask.
+func (m *MenuModel) RenameSubtask() tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return UpdateSubtaskMsg{Error: errors.New("no subtask selected")}
+		}
+		selected.Subtask.Title = m.Input.Value()
+		subtask, err := m.Client.UpdateSubtask(m.ctx, &selected.Subtask)
+		return UpdateSubtaskMsg{
+			subtask,
+			err,
+		}
+	}
+}
+
+// InitAddSubtask sets list state to AddSubtaskState to render text input.
+func (m *MenuModel) InitAddSubtask() tea.Cmd {
+	m.State = AddSubtaskState
+	m.Input.Placeholder = "Enter subtask"
+	m.Input.SetValue("")
+	m.Input.Focus()
+	return nil
+}
+
+// InitRenameSubtask sets list state to RenameSubtaskState to render text input.
+func (m *MenuModel) InitRenameSubtask() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no subtask selected"))
+	}
+	m.State = RenameSubtaskState
+	m.Input.SetValue(selected.Subtask.Title)
+	m.Input.CursorEnd()
+	m.Input.Focus()
+	return nil
+}
+
+// ToggleSubtask flips the completion state of selected subtask.
+func (m *MenuModel) ToggleSubtask() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no subtask selected"))
+	}
+	return func() tea.Msg {
+		subtask, err := m.Client.ToggleSubtask(m.ctx, &selected.Subtask)
+		return UpdateSubtaskMsg{
+			subtask,
+			err,
+		}
+	}
+}
+
+// MoveSubtask moves selected subtask by delta positions.
+func (m *MenuModel) MoveSubtask(delta int) tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no subtask selected"))
+	}
+	index := m.List.Index() + delta
+	if index < 0 || index >= len(m.List.Items()) {
+		return nil
+	}
+	return func() tea.Msg {
+		subtasks, err := m.Client.MoveSubtask(m.ctx, &selected.Subtask, index)
+		return MoveSubtaskMsg{
+			Subtasks: subtasks,
+			Index:    index,
+			Error:    err,
+		}
+	}
+}
+
+// DeleteSubtask deletes current selected subtask.
+func (m *MenuModel) DeleteSubtask() tea.Cmd {
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return DeleteSubtaskMsg{Error: errors.New("no subtask selected")}
+		}
+		err := m.Client.DeleteSubtask(m.ctx, &selected.Subtask)
+		return DeleteSubtaskMsg{Error: err, Subtask: &selected.Subtask}
+	}
+}
+
+func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	if m.State != DefaultState {
+		m.Input, cmds = m.HandleInputState(msg)
+		return m, tea.Batch(cmds...)
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		cmd := m.HandleWindowSize(msg)
+		cmds = append(cmds, cmd)
+
+	case tea.KeyPressMsg:
+		cmd := m.HandleKeyInput(msg)
+		cmds = append(cmds, cmd)
+
+	case ErrorMsg:
+		cmd := m.HandleError(msg)
+		cmds = append(cmds, cmd)
+
+	case ListSubtasksMsg:
+		m.List.SetItems(NewList(msg.Subtasks))
+
+	case AddSubtaskMsg:
+		cmd := m.HandleAddSubtask(msg)
+		cmds = append(cmds, cmd)
+
+	case UpdateSubtaskMsg:
+		cmd := m.HandleUpdateSubtask(msg)
+		cmds = append(cmds, cmd)
+
+	case MoveSubtaskMsg:
+		cmd := m.HandleMoveSubtask(msg)
+		cmds = append(cmds, cmd)
+
+	case DeleteSubtaskMsg:
+		cmd := m.HandleDeleteSubtask(msg)
+		cmds = append(cmds, cmd)
+		cmd = m.ListSubtasks()
+		cmds = append(cmds, cmd)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && keyMsg.Code == tea.KeyEsc {
+		return m, tea.Batch(cmds...)
+	}
+
+	listModel, listCmd := m.List.Update(msg)
+	m.List = listModel
+	cmds = append(cmds, listCmd)
+
+	return m, tea.Batch(cmds...)
+}
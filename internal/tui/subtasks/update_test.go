@@ -0,0 +1,60 @@
+package subtasks
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubtaskAddRenameToggleDeleteFlow(t *testing.T) {
+	menu, cleanup := newSubtaskMenu(t)
+	defer cleanup()
+
+	apply := func(cmd tea.Cmd) {
+		t.Helper()
+		require.NotNil(t, cmd)
+		if msg := cmd(); msg != nil {
+			model, _ := menu.Update(msg)
+			menu = model.(MenuModel)
+		}
+	}
+
+	menu.InitAddSubtask()
+	menu.Input.SetValue("three")
+	model, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	menu = model.(MenuModel)
+	assert.Equal(t, DefaultState, menu.State)
+	apply(cmd)
+	require.Len(t, menu.List.Items(), 3)
+	assert.Equal(t, "three", menu.List.Items()[2].(Item).Subtask.Title)
+
+	menu.InitRenameSubtask()
+	menu.Input.SetValue("first")
+	model, cmd = menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	menu = model.(MenuModel)
+	apply(cmd)
+	renamed, ok := menu.selectedItem()
+	require.True(t, ok)
+	assert.Equal(t, "first", renamed.Subtask.Title)
+
+	apply(menu.ToggleSubtask())
+	toggled, ok := menu.selectedItem()
+	require.True(t, ok)
+	assert.True(t, toggled.Subtask.Completed)
+
+	apply(menu.MoveSubtask(1))
+	assert.Equal(t, 1, menu.List.Index())
+	moved, ok := menu.selectedItem()
+	require.True(t, ok)
+	assert.Equal(t, "first", moved.Subtask.Title)
+
+	apply(menu.DeleteSubtask())
+	apply(menu.ListSubtasks())
+	assert.Len(t, menu.List.Items(), 2)
+
+	subtasks, err := menu.Client.ListSubtasks(menu.ctx, &menu.Item)
+	require.NoError(t, err)
+	assert.Len(t, *subtasks, 2)
+}
@@ -0,0 +1,56 @@
+package subtasks_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/subtasks"
+)
+
+func TestSubtasksViewForListAndInputStates(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(*subtasks.MenuModel)
+		assertView func(*testing.T, string)
+	}{
+		{
+			name: "list state renders content",
+			setup: func(menu *subtasks.MenuModel) {
+				menu.List.SetSize(80, 20)
+			},
+			assertView: func(t *testing.T, view string) {
+				assert.NotEmpty(t, strings.TrimSpace(view))
+			},
+		},
+		{
+			name: "input state renders typed text",
+			setup: func(menu *subtasks.MenuModel) {
+				menu.State = subtasks.AddSubtaskState
+				menu.Input.SetValue("new step")
+			},
+			assertView: func(t *testing.T, view string) {
+				assert.Contains(t, view, "new step")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board, err := svc.CreateBoard(ctx, "Inbox")
+			require.NoError(t, err)
+			item, err := svc.CreateItem(ctx, board, "project", "", nil)
+			require.NoError(t, err)
+			menu := subtasks.New(ctx, svc, item)
+			tt.setup(&menu)
+			tt.assertView(t, menu.View().Content)
+		})
+	}
+}
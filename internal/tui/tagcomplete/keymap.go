@@ -0,0 +1,26 @@
+package tagcomplete
+
+import "charm.land/bubbles/v2/key"
+
+type Keymap struct {
+	Accept key.Binding
+	Next   key.Binding
+	Prev   key.Binding
+}
+
+func NewKeymap() Keymap {
+	return Keymap{
+		Accept: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "accept suggestion"),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("down", "ctrl+n"),
+			key.WithHelp("↓", "next suggestion"),
+		),
+		Prev: key.NewBinding(
+			key.WithKeys("up", "ctrl+p"),
+			key.WithHelp("↑", "previous suggestion"),
+		),
+	}
+}
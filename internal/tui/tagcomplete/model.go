@@ -0,0 +1,144 @@
+package tagcomplete
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/sahilm/fuzzy"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/helpers"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+const maxSuggestions = 5
+
+// Model suggests existing tags for the last entry of a comma-separated tag
+// input. Suggestions are fuzzy matches ranked by how many items use the tag.
+type Model struct {
+	Keys        Keymap
+	usage       []service.TagUsage
+	suggestions []service.TagUsage
+	selected    int
+}
+
+// Load builds a completer from the tags currently in use.
+func Load(ctx context.Context, svc *service.Service) (Model, error) {
+	usage, err := svc.ListTagUsage(ctx)
+	if err != nil {
+		return Model{}, err
+	}
+	return New(usage), nil
+}
+
+// New builds a completer for usage, which must be sorted by uses, most used
+// first.
+func New(usage []service.TagUsage) Model {
+	return Model{
+		Keys:  NewKeymap(),
+		usage: usage,
+	}
+}
+
+// SetValue recomputes the suggestions for the input value. Tags already
+// entered earlier in the input are not suggested again.
+func (m *Model) SetValue(value string) {
+	entries := strings.Split(value, helpers.TagsSeparator)
+	term := strings.TrimSpace(entries[len(entries)-1])
+	entered := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		entered[strings.TrimSpace(entry)] = true
+	}
+
+	candidates := make([]service.TagUsage, 0, len(m.usage))
+	for _, u := range m.usage {
+		if !entered[u.Tag] {
+			candidates = append(candidates, u)
+		}
+	}
+
+	m.selected = 0
+	if term == "" {
+		m.suggestions = candidates[:min(len(candidates), maxSuggestions)]
+		return
+	}
+
+	names := make([]string, len(candidates))
+	for i, c := range candidates {
+		names[i] = c.Tag
+	}
+	matches := fuzzy.Find(term, names)
+	slices.SortStableFunc(matches, func(a, b fuzzy.Match) int {
+		return cmp.Or(
+			cmp.Compare(candidates[b.Index].Uses, candidates[a.Index].Uses),
+			cmp.Compare(b.Score, a.Score),
+		)
+	})
+	m.suggestions = make([]service.TagUsage, 0, maxSuggestions)
+	for _, match := range matches[:min(len(matches), maxSuggestions)] {
+		m.suggestions = append(m.suggestions, candidates[match.Index])
+	}
+}
+
+// Suggestions returns the current suggestions, best first.
+func (m Model) Suggestions() []string {
+	tags := make([]string, len(m.suggestions))
+	for i, s := range m.suggestions {
+		tags[i] = s.Tag
+	}
+	return tags
+}
+
+// Complete replaces the last entry of value with the selected suggestion.
+func (m Model) Complete(value string) string {
+	if len(m.suggestions) == 0 {
+		return value
+	}
+	entries := strings.Split(value, helpers.TagsSeparator)
+	tag := m.suggestions[m.selected].Tag
+	if len(entries) > 1 {
+		tag = " " + tag
+	}
+	entries[len(entries)-1] = tag
+	return strings.Join(entries, helpers.TagsSeparator)
+}
+
+// Update moves the selection or completes value. It reports whether msg was
+// a completion key, in which case the input must not handle it.
+func (m Model) Update(msg tea.KeyPressMsg, value string) (Model, string, bool) {
+	if len(m.suggestions) == 0 {
+		return m, value, false
+	}
+	switch {
+	case key.Matches(msg, m.Keys.Accept):
+		value = m.Complete(value)
+		m.SetValue(value)
+		return m, value, true
+	case key.Matches(msg, m.Keys.Next):
+		m.selected = (m.selected + 1) % len(m.suggestions)
+		return m, value, true
+	case key.Matches(msg, m.Keys.Prev):
+		m.selected = (m.selected - 1 + len(m.suggestions)) % len(m.suggestions)
+		return m, value, true
+	}
+	return m, value, false
+}
+
+// View renders the suggestion dropdown, or nothing without suggestions.
+func (m Model) View() string {
+	lines := make([]string, len(m.suggestions))
+	for i, s := range m.suggestions {
+		line := fmt.Sprintf("%s (%d)", s.Tag, s.Uses)
+		if i == m.selected {
+			lines[i] = styles.SelectedSuggestion.Render("> " + line)
+		} else {
+			lines[i] = styles.Suggestion.Render("  " + line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
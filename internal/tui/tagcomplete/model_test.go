@@ -0,0 +1,115 @@
+package tagcomplete_test
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/tagcomplete"
+)
+
+func newCompleter() tagcomplete.Model {
+	usage := []service.TagUsage{
+		{ListTagUsageRow: repository.ListTagUsageRow{Tag: "work", Uses: 9}},
+		{ListTagUsageRow: repository.ListTagUsageRow{Tag: "home", Uses: 5}},
+		{ListTagUsageRow: repository.ListTagUsageRow{Tag: "work/infra", Uses: 3}},
+		{ListTagUsageRow: repository.ListTagUsageRow{Tag: "wishlist", Uses: 2}},
+		{ListTagUsageRow: repository.ListTagUsageRow{Tag: "errands", Uses: 1}},
+		{ListTagUsageRow: repository.ListTagUsageRow{Tag: "go", Uses: 1}},
+	}
+	return tagcomplete.New(usage)
+}
+
+func TestSuggestions(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "empty input suggests most used tags", value: "", want: []string{"work", "home", "work/infra", "wishlist", "errands"}},
+		{name: "fuzzy matches are ranked by usage", value: "wr", want: []string{"work", "work/infra"}},
+		{name: "only the last entry is completed", value: "home, in", want: []string{"work/infra"}},
+		{name: "entered tags are not suggested again", value: "work, ", want: []string{"home", "work/infra", "wishlist", "errands", "go"}},
+		{name: "no matches", value: "zzz", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completer := newCompleter()
+			completer.SetValue(tt.value)
+			assert.Equal(t, tt.want, completer.Suggestions())
+		})
+	}
+}
+
+func TestCompletionKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		keys          []tea.KeyPressMsg
+		wantValue     string
+		wantCompleted bool
+	}{
+		{
+			name:          "tab accepts first suggestion",
+			value:         "home, wo",
+			keys:          []tea.KeyPressMsg{{Code: tea.KeyTab}},
+			wantValue:     "home, work",
+			wantCompleted: true,
+		},
+		{
+			name:          "down then tab accepts second suggestion",
+			value:         "wo",
+			keys:          []tea.KeyPressMsg{{Code: tea.KeyDown}, {Code: tea.KeyTab}},
+			wantValue:     "work/infra",
+			wantCompleted: true,
+		},
+		{
+			name:          "up wraps to last suggestion",
+			value:         "wo",
+			keys:          []tea.KeyPressMsg{{Code: tea.KeyUp}, {Code: tea.KeyTab}},
+			wantValue:     "work/infra",
+			wantCompleted: true,
+		},
+		{
+			name:      "keys pass through without suggestions",
+			value:     "zzz",
+			keys:      []tea.KeyPressMsg{{Code: tea.KeyTab}},
+			wantValue: "zzz",
+		},
+		{
+			name:      "other keys pass through",
+			value:     "wo",
+			keys:      []tea.KeyPressMsg{{Code: 'r', Text: "r"}},
+			wantValue: "wo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completer := newCompleter()
+			completer.SetValue(tt.value)
+			value := tt.value
+			var completed bool
+			for _, msg := range tt.keys {
+				completer, value, completed = completer.Update(msg, value)
+			}
+			assert.Equal(t, tt.wantValue, value)
+			assert.Equal(t, tt.wantCompleted, completed)
+		})
+	}
+
+	t.Run("view marks selected suggestion", func(t *testing.T) {
+		completer := newCompleter()
+		completer.SetValue("wo")
+		view := completer.View()
+		assert.Contains(t, view, "> work (9)")
+		assert.Contains(t, view, "  work/infra (3)")
+
+		completer.SetValue("zzz")
+		assert.Empty(t, completer.View())
+	})
+}
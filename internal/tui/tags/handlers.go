@@ -6,6 +6,7 @@ import (
 	"github.com/rhajizada/donezo/internal/tui/styles"
 
 	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/rhajizada/donezo/internal/tui/navigation"
@@ -43,11 +44,127 @@ func (m *MenuModel) HandleDeleteTag(msg DeleteTagMsg) tea.Cmd {
 	)
 }
 
+// HandleRenameTag handles RenameTagMsg.
+func (m *MenuModel) HandleRenameTag(msg RenameTagMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed renaming tag: %v", msg.Error),
+			),
+		)
+	}
+
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("renamed tag \"%s\" to \"%s\"", msg.Tag, msg.Name),
+		),
+	)
+}
+
+// HandleMergeTag handles MergeTagMsg.
+func (m *MenuModel) HandleMergeTag(msg MergeTagMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed merging tag: %v", msg.Error),
+			),
+		)
+	}
+
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("merged tag \"%s\" into \"%s\"", msg.Tag, msg.Target),
+		),
+	)
+}
+
+// HandleUpdateTagMetadata handles UpdateTagMetadataMsg.
+func (m *MenuModel) HandleUpdateTagMetadata(msg UpdateTagMetadataMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed updating tag: %v", msg.Error),
+			),
+		)
+	}
+
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("updated tag \"%s\"", msg.Meta.Tag),
+		),
+	)
+}
+
+// HandleInputState handles the rename, merge and edit input states.
+func (m *MenuModel) HandleInputState(msg tea.Msg) (textinput.Model, []tea.Cmd) {
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	m.Input, cmd = m.Input.Update(msg)
+	cmds = append(cmds, cmd)
+
+	// Only handle key messages in input states
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok {
+		switch keyMsg.Code {
+		case tea.KeyEnter:
+			switch m.State {
+			case RenameTagState:
+				cmds = append(cmds, m.RenameTag())
+			case MergeTagState:
+				cmds = append(cmds, m.MergeTag())
+			case EditColorState:
+				m.Edit.Color = m.Input.Value()
+				m.State = EditEmojiState
+				m.Input.Placeholder = "Enter emoji, empty for none"
+				m.Input.SetValue(m.Edit.Emoji)
+				m.Input.CursorEnd()
+				return m.Input, cmds
+			case EditEmojiState:
+				m.Edit.Emoji = m.Input.Value()
+				m.State = EditDescState
+				m.Input.Placeholder = "Enter tag description"
+				m.Input.SetValue(m.Edit.Description)
+				m.Input.CursorEnd()
+				return m.Input, cmds
+			case EditDescState:
+				m.Edit.Description = m.Input.Value()
+				cmds = append(cmds, m.UpdateTagMetadata())
+			case QueryTagsState:
+				cmds = append(cmds, m.QueryTags())
+			case DefaultState, CreateTagState:
+				// no-op
+			}
+			m.State = DefaultState
+			m.Input.Blur()
+		case tea.KeyEsc:
+			// Cancel the current operation
+			m.State = DefaultState
+			m.Input.Blur()
+		default:
+			// ignore other key types
+		}
+	}
+
+	return m.Input, cmds
+}
+
 // HandleKeyInput processes key inputs not handles by list.Model.
 func (m *MenuModel) HandleKeyInput(msg tea.KeyPressMsg) tea.Cmd {
 	var cmd tea.Cmd
 	if !m.List.SettingFilter() {
 		switch {
+		case key.Matches(msg, m.Keys.ToggleTag):
+			cmd = m.ToggleTag()
+		case key.Matches(msg, m.Keys.RenameTag):
+			cmd = m.InitRenameTag()
+		case key.Matches(msg, m.Keys.MergeTag):
+			cmd = m.InitMergeTag()
+		case key.Matches(msg, m.Keys.EditTag):
+			cmd = m.InitEditTag()
+		case key.Matches(msg, m.Keys.SortTags):
+			cmd = m.SortTags()
+		case key.Matches(msg, m.Keys.QueryTags):
+			cmd = m.InitQueryTags()
 		case key.Matches(msg, m.Keys.DeleteTag):
 			cmd = m.DeleteTag()
 		case key.Matches(msg, m.Keys.RefreshList):
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
 	"github.com/rhajizada/donezo/internal/tui/navigation"
 )
 
@@ -19,7 +20,7 @@ func newTagMenu(t *testing.T) (MenuModel, func()) {
 
 	board, err := svc.CreateBoard(ctx, "Inbox")
 	require.NoError(t, err)
-	item, err := svc.CreateItem(ctx, board, "task", "desc")
+	item, err := svc.CreateItem(ctx, board, "task", "desc", nil)
 	require.NoError(t, err)
 	item.Tags = []string{"work"}
 	_, err = svc.UpdateItem(ctx, item)
@@ -89,14 +90,13 @@ func TestTagsKeyBindings(t *testing.T) {
 		menu, cleanup := newTagMenu(t)
 		defer cleanup()
 
-		var captured []byte
-		prev := writeClipboardText
-		writeClipboardText = func(data []byte) { captured = append([]byte{}, data...) }
-		defer func() { writeClipboardText = prev }()
+		menu.Clipboard = clipboard.NewRegister("")
 
 		_, cmd := menu.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
 		require.NotNil(t, cmd)
 		cmd()
+		captured, err := menu.Clipboard.Read()
+		require.NoError(t, err)
 		assert.NotEmpty(t, captured)
 	})
 }
@@ -2,14 +2,26 @@ package tags
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/list"
+
+	"github.com/rhajizada/donezo/internal/service"
 )
 
-// Item represents item in the list.
+// Item represents item in the list. Count, Open, Done and LastActivityAt
+// include the items of every tag below Tag.
 type Item struct {
-	Tag   string
-	Count int64
+	Tag            string
+	Count          int64
+	Open           int64
+	Done           int64
+	LastActivityAt *time.Time
+	Meta           service.TagMetadata
+	Depth          int
+	HasChildren    bool
+	Collapsed      bool
 }
 
 func NewList(tags []Item) []list.Item {
@@ -27,12 +39,48 @@ func NewItem(tag string, count int64) Item {
 	}
 }
 
-func (i Item) Title() string { return i.Tag }
+// NewSummaryItem builds an item with the open/done breakdown of summary.
+func NewSummaryItem(summary service.TagSummary) Item {
+	return Item{
+		Tag:            summary.Tag,
+		Count:          summary.Total(),
+		Open:           summary.Open,
+		Done:           summary.Done,
+		LastActivityAt: summary.LastActivityAt,
+	}
+}
+
+func (i Item) Title() string {
+	name := i.Tag[strings.LastIndex(i.Tag, service.TagPathSeparator)+1:]
+	if i.Depth == 0 {
+		name = i.Tag
+	}
+	if i.Meta.Emoji != "" {
+		name = i.Meta.Emoji + " " + name
+	}
+	switch {
+	case i.Collapsed:
+		name = "▸ " + name
+	case i.HasChildren:
+		name = "▾ " + name
+	}
+	return strings.Repeat("  ", i.Depth) + name
+}
 func (i Item) Description() string {
 	var suffix string
 	if i.Count != 1 {
 		suffix = "s"
 	}
-	return fmt.Sprintf("%d item%s", i.Count, suffix)
+	desc := fmt.Sprintf("%d item%s", i.Count, suffix)
+	if i.Open+i.Done > 0 {
+		desc += fmt.Sprintf(": %d open, %d done", i.Open, i.Done)
+	}
+	if i.LastActivityAt != nil {
+		desc += " | active " + i.LastActivityAt.Local().Format("01-02-2006 15:04")
+	}
+	if i.Meta.Description != "" {
+		desc += " | " + i.Meta.Description
+	}
+	return desc
 }
 func (i Item) FilterValue() string { return i.Tag }
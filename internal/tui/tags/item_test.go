@@ -2,13 +2,17 @@ package tags_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/tui/tags"
 )
 
 func TestTagItemAccessors(t *testing.T) {
+	activity := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
 	tests := []struct {
 		name            string
 		item            tags.Item
@@ -30,6 +34,44 @@ func TestTagItemAccessors(t *testing.T) {
 			wantFilterValue: "solo",
 			wantDescription: "1 item",
 		},
+		{
+			name: "metadata adds emoji and description",
+			item: tags.Item{Tag: "work", Count: 3, Meta: service.TagMetadata{TagMetadata: repository.TagMetadata{
+				Tag:         "work",
+				Emoji:       "💼",
+				Description: "day job",
+			}}},
+			wantTitle:       "💼 work",
+			wantFilterValue: "work",
+			wantDescription: "3 items | day job",
+		},
+		{
+			name: "open and done breakdown with last activity",
+			item: tags.Item{
+				Tag:            "work",
+				Count:          3,
+				Open:           2,
+				Done:           1,
+				LastActivityAt: &activity,
+			},
+			wantTitle:       "work",
+			wantFilterValue: "work",
+			wantDescription: "3 items: 2 open, 1 done | active " + activity.Local().Format("01-02-2006 15:04"),
+		},
+		{
+			name:            "expanded parent",
+			item:            tags.Item{Tag: "work", Count: 2, HasChildren: true},
+			wantTitle:       "▾ work",
+			wantFilterValue: "work",
+			wantDescription: "2 items",
+		},
+		{
+			name:            "collapsed child shows last level indented",
+			item:            tags.Item{Tag: "work/infra", Count: 1, Depth: 1, HasChildren: true, Collapsed: true},
+			wantTitle:       "  ▸ infra",
+			wantFilterValue: "work/infra",
+			wantDescription: "1 item",
+		},
 	}
 
 	for _, tt := range tests {
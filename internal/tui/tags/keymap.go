@@ -2,27 +2,54 @@ package tags
 
 import (
 	"charm.land/bubbles/v2/key"
+
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
 )
 
 // Keymap embeds default list keymap and adds other Binding.
 type Keymap struct {
 	Choose      key.Binding
+	ToggleTag   key.Binding
 	ListBoards  key.Binding
+	RenameTag   key.Binding
+	MergeTag    key.Binding
+	EditTag     key.Binding
+	SortTags    key.Binding
+	QueryTags   key.Binding
 	DeleteTag   key.Binding
 	RefreshList key.Binding
 	Copy        key.Binding
 }
 
 func NewKeymap() Keymap {
-	return Keymap{
+	km := Keymap{
 		Choose: key.NewBinding(
 			key.WithKeys("enter", "return"),
 			key.WithHelp("enter", "choose tag"),
 		),
+		ToggleTag: key.NewBinding(
+			key.WithKeys("space"),
+			key.WithHelp("space", "expand/collapse"),
+		),
 		ListBoards: key.NewBinding(
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "list boards"),
 		),
+		RenameTag: key.NewBinding(key.WithKeys("r"),
+			key.WithHelp("r", "rename tag"),
+		),
+		MergeTag: key.NewBinding(key.WithKeys("m"),
+			key.WithHelp("m", "merge into tag"),
+		),
+		EditTag: key.NewBinding(key.WithKeys("e"),
+			key.WithHelp("e", "edit color, emoji and description"),
+		),
+		SortTags: key.NewBinding(key.WithKeys("s"),
+			key.WithHelp("s", "cycle sort order"),
+		),
+		QueryTags: key.NewBinding(key.WithKeys("Q"),
+			key.WithHelp("Q", "query tags with AND/OR/NOT"),
+		),
 		DeleteTag: key.NewBinding(key.WithKeys("d"),
 			key.WithHelp("d", "delete tag"),
 		),
@@ -30,9 +57,11 @@ func NewKeymap() Keymap {
 			key.WithHelp("R", "refresh list"),
 		),
 		Copy: key.NewBinding(key.WithKeys("y"),
-			key.WithHelp("y", "copy tag to system clipboard"),
+			key.WithHelp("y", "copy tag to clipboard"),
 		),
 	}
+	keybindings.Apply("tags", &km)
+	return km
 }
 
 func (km Keymap) ShortHelp() []key.Binding {
@@ -45,6 +74,12 @@ func (km Keymap) ShortHelp() []key.Binding {
 func (km Keymap) FullHelp() []key.Binding {
 	bindings := []key.Binding{}
 	bindings = append(bindings, km.Choose)
+	bindings = append(bindings, km.ToggleTag)
+	bindings = append(bindings, km.RenameTag)
+	bindings = append(bindings, km.MergeTag)
+	bindings = append(bindings, km.EditTag)
+	bindings = append(bindings, km.SortTags)
+	bindings = append(bindings, km.QueryTags)
 	bindings = append(bindings, km.DeleteTag)
 	bindings = append(bindings, km.RefreshList)
 	bindings = append(bindings, km.Copy)
@@ -1,5 +1,7 @@
 package tags
 
+import "github.com/rhajizada/donezo/internal/service"
+
 type ErrorMsg struct {
 	Error error
 }
@@ -8,6 +10,23 @@ type ListTagsMsg struct {
 	Tags []Item
 }
 
+type RenameTagMsg struct {
+	Tag   string
+	Name  string
+	Error error
+}
+
+type MergeTagMsg struct {
+	Tag    string
+	Target string
+	Error  error
+}
+
+type UpdateTagMetadataMsg struct {
+	Meta  *service.TagMetadata
+	Error error
+}
+
 type DeleteTagMsg struct {
 	Tag   string
 	Error error
@@ -4,17 +4,28 @@ import (
 	"context"
 
 	"charm.land/bubbles/v2/list"
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
+	"github.com/rhajizada/donezo/internal/tui/itemlist"
 )
 
 //nolint:recvcheck // Mixed receivers align with tea.Model usage patterns.
 type MenuModel struct {
-	ctx    context.Context
-	List   list.Model
-	Keys   *Keymap
-	Client *service.Service
+	ctx       context.Context
+	List      list.Model
+	Input     textinput.Model
+	Keys      *Keymap
+	State     InputState
+	Edit      service.TagMetadata
+	Tree      []Item
+	Order     service.TagOrder
+	Query     string
+	Collapsed map[string]bool
+	Clipboard clipboard.Clipboard
+	Client    *service.Service
 }
 
 // NewModel constructs a new tag list menu.
@@ -25,15 +36,22 @@ func NewModel(ctx context.Context, client *service.Service) MenuModel {
 		0,
 		0,
 	)
+	input := textinput.New()
 	keymap := NewKeymap()
+	list.KeyMap = itemlist.DefaultKeyMap().List()
 	list.Title = "donezo | Tags"
 	list.AdditionalShortHelpKeys = keymap.ShortHelp
 	list.AdditionalFullHelpKeys = keymap.FullHelp
 	return MenuModel{
-		ctx:    ctx,
-		List:   list,
-		Keys:   &keymap,
-		Client: client,
+		ctx:       ctx,
+		List:      list,
+		Input:     input,
+		Keys:      &keymap,
+		State:     DefaultState,
+		Order:     service.OrderTagsByName,
+		Collapsed: map[string]bool{},
+		Clipboard: clipboard.Current(),
+		Client:    client,
 	}
 }
 
@@ -6,4 +6,9 @@ const (
 	DefaultState InputState = iota
 	CreateTagState
 	RenameTagState
+	MergeTagState
+	EditColorState
+	EditEmojiState
+	EditDescState
+	QueryTagsState
 )
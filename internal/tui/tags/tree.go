@@ -0,0 +1,26 @@
+package tags
+
+import (
+	"strings"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+// visibleTags drops the tags below collapsed tags and marks collapsed tags.
+// tree must list every tag right before the tags below it, as SortTags does.
+func visibleTags(tree []Item, collapsed map[string]bool) []Item {
+	visible := make([]Item, 0, len(tree))
+	hidden := ""
+	for _, item := range tree {
+		if hidden != "" && strings.HasPrefix(item.Tag, hidden) {
+			continue
+		}
+		hidden = ""
+		item.Collapsed = item.HasChildren && collapsed[item.Tag]
+		if item.Collapsed {
+			hidden = item.Tag + service.TagPathSeparator
+		}
+		visible = append(visible, item)
+	}
+	return visible
+}
@@ -0,0 +1,56 @@
+package tags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVisibleTags(t *testing.T) {
+	tree := []Item{
+		{Tag: "home"},
+		{Tag: "work", HasChildren: true},
+		{Tag: "work/infra", Depth: 1, HasChildren: true},
+		{Tag: "work/infra/k8s", Depth: 2},
+		{Tag: "work/ui", Depth: 1},
+		{Tag: "workshop"},
+	}
+
+	tests := []struct {
+		name      string
+		collapsed map[string]bool
+		want      []string
+	}{
+		{
+			name: "expanded tree shows every tag",
+			want: []string{"home", "work", "work/infra", "work/infra/k8s", "work/ui", "workshop"},
+		},
+		{
+			name:      "collapsed parent hides its subtree only",
+			collapsed: map[string]bool{"work": true},
+			want:      []string{"home", "work", "workshop"},
+		},
+		{
+			name:      "collapsed child hides its own children",
+			collapsed: map[string]bool{"work/infra": true},
+			want:      []string{"home", "work", "work/infra", "work/ui", "workshop"},
+		},
+		{
+			name:      "leaf cannot be collapsed",
+			collapsed: map[string]bool{"home": true},
+			want:      []string{"home", "work", "work/infra", "work/infra/k8s", "work/ui", "workshop"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visible := visibleTags(tree, tt.collapsed)
+			got := make([]string, len(visible))
+			for i, item := range visible {
+				got[i] = item.Tag
+				assert.Equal(t, tt.collapsed[item.Tag] && item.HasChildren, item.Collapsed)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,19 +3,16 @@ package tags
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	tea "charm.land/bubbletea/v2"
-	"golang.design/x/clipboard"
 
 	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/navigation"
 	"github.com/rhajizada/donezo/internal/tui/styles"
 )
 
-//nolint:gochecknoglobals // injectable for tests
-var writeClipboardText = func(data []byte) {
-	clipboard.Write(clipboard.FmtText, data)
-}
-
 func (m *MenuModel) selectedItem() (Item, bool) {
 	item, ok := m.List.SelectedItem().(Item)
 	return item, ok
@@ -24,14 +21,18 @@ func (m *MenuModel) selectedItem() (Item, bool) {
 // ListTags fetches the list of tags from the client.
 func (m *MenuModel) ListTags() tea.Cmd {
 	return func() tea.Msg {
-		data, err := m.Client.ListTags(m.ctx)
-		tags := make([]Item, len(data))
+		data, err := m.Client.ListTagSummaries(m.ctx)
+		if err != nil {
+			return ErrorMsg{err}
+		}
+		meta, err := m.Client.ListTagMetadata(m.ctx)
 		if err != nil {
 			return ErrorMsg{err}
 		}
+		tags := make([]Item, len(data))
 		for i, v := range data {
-			count, _ := m.Client.CountItemsByTag(m.ctx, v)
-			tags[i] = NewItem(v, count)
+			tags[i] = NewSummaryItem(v)
+			tags[i].Meta = meta[v.Tag]
 		}
 		return ListTagsMsg{
 			tags,
@@ -39,7 +40,66 @@ func (m *MenuModel) ListTags() tea.Cmd {
 	}
 }
 
-// Copy copies tag to system clipboard.
+// SortTags switches to the next sort order and re-sorts the tag tree.
+func (m *MenuModel) SortTags() tea.Cmd {
+	m.Order = m.Order.Next()
+	m.setTree(m.Tree)
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("sorted by %s", m.Order),
+		),
+	)
+}
+
+// setTree orders tags by the current sort order, works out their place in
+// the hierarchy and lists the visible ones.
+func (m *MenuModel) setTree(tags []Item) {
+	summaries := make([]service.TagSummary, len(tags))
+	byTag := make(map[string]Item, len(tags))
+	for i, tag := range tags {
+		summaries[i] = service.TagSummary{
+			Tag:            tag.Tag,
+			Open:           tag.Open,
+			Done:           tag.Done,
+			LastActivityAt: tag.LastActivityAt,
+		}
+		byTag[tag.Tag] = tag
+	}
+	service.SortTags(summaries, m.Order)
+	tree := make([]Item, len(summaries))
+	for i, v := range summaries {
+		tree[i] = byTag[v.Tag]
+		tree[i].Depth = strings.Count(v.Tag, service.TagPathSeparator)
+		tree[i].HasChildren = i+1 < len(summaries) &&
+			strings.HasPrefix(summaries[i+1].Tag, v.Tag+service.TagPathSeparator)
+	}
+	m.Tree = tree
+	m.showTree()
+}
+
+// ToggleTag collapses or expands the tags below the selected tag.
+func (m *MenuModel) ToggleTag() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok || !selected.HasChildren {
+		return nil
+	}
+	m.Collapsed[selected.Tag] = !m.Collapsed[selected.Tag]
+	m.showTree()
+	return nil
+}
+
+// showTree lists the visible part of the tag tree, keeping the selection on
+// the same tag.
+func (m *MenuModel) showTree() {
+	selected, _ := m.selectedItem()
+	visible := visibleTags(m.Tree, m.Collapsed)
+	m.List.SetItems(NewList(visible))
+	if idx := slices.IndexFunc(visible, func(i Item) bool { return i.Tag == selected.Tag }); idx >= 0 {
+		m.List.Select(idx)
+	}
+}
+
+// Copy copies the items of the selected tag as markdown to the clipboard.
 func (m *MenuModel) Copy() tea.Cmd {
 	current, ok := m.selectedItem()
 	if !ok {
@@ -54,12 +114,120 @@ func (m *MenuModel) Copy() tea.Cmd {
 		}
 	}
 	md := service.ItemsToMarkdown(currentTag, *items)
-	writeClipboardText([]byte(md))
-	return m.List.NewStatusMessage(
+	copyCmd, err := m.Clipboard.Write([]byte(md))
+	if err != nil {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render(err.Error()))
+	}
+	return tea.Batch(copyCmd, m.List.NewStatusMessage(
 		styles.StatusMessage.Render(
-			fmt.Sprintf("copied \"%s\" to system clipboard", currentTag),
+			fmt.Sprintf("copied \"%s\" to clipboard", currentTag),
 		),
-	)
+	))
+}
+
+// RenameTag renames selected tag on every item.
+func (m *MenuModel) RenameTag() tea.Cmd {
+	name := m.Input.Value()
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return RenameTagMsg{Error: errors.New("no tag selected")}
+		}
+		err := m.Client.RenameTag(m.ctx, selected.Tag, name)
+		return RenameTagMsg{Tag: selected.Tag, Name: name, Error: err}
+	}
+}
+
+// MergeTag merges selected tag into the tag entered in the input.
+func (m *MenuModel) MergeTag() tea.Cmd {
+	target := m.Input.Value()
+	return func() tea.Msg {
+		selected, ok := m.selectedItem()
+		if !ok {
+			return MergeTagMsg{Error: errors.New("no tag selected")}
+		}
+		err := m.Client.MergeTags(m.ctx, target, selected.Tag)
+		return MergeTagMsg{Tag: selected.Tag, Target: target, Error: err}
+	}
+}
+
+// InitRenameTag sets list state to RenameTagState to render text input.
+func (m *MenuModel) InitRenameTag() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no tag selected"))
+	}
+	m.State = RenameTagState
+	m.Input.Placeholder = "Enter tag name"
+	m.Input.SetValue(selected.Tag)
+	m.Input.CursorEnd()
+	m.Input.Focus()
+	return nil
+}
+
+// InitMergeTag sets list state to MergeTagState to render text input.
+func (m *MenuModel) InitMergeTag() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no tag selected"))
+	}
+	m.State = MergeTagState
+	m.Input.Placeholder = fmt.Sprintf("Enter tag to merge \"%s\" into", selected.Tag)
+	m.Input.SetValue("")
+	m.Input.Focus()
+	return nil
+}
+
+// UpdateTagMetadata saves the metadata collected by the edit prompts.
+func (m *MenuModel) UpdateTagMetadata() tea.Cmd {
+	meta := m.Edit
+	return func() tea.Msg {
+		saved, err := m.Client.UpdateTagMetadata(m.ctx, &meta)
+		return UpdateTagMetadataMsg{Meta: saved, Error: err}
+	}
+}
+
+// InitEditTag starts prompting for the color, emoji and description of the
+// selected tag, one after another.
+func (m *MenuModel) InitEditTag() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("no tag selected"))
+	}
+	m.Edit = selected.Meta
+	m.Edit.Tag = selected.Tag
+	m.State = EditColorState
+	m.Input.Placeholder = "Enter color as #rrggbb or 0-255, empty for none"
+	m.Input.SetValue(m.Edit.Color)
+	m.Input.CursorEnd()
+	m.Input.Focus()
+	return nil
+}
+
+// InitQueryTags sets list state to QueryTagsState to render text input,
+// starting from the previous query.
+func (m *MenuModel) InitQueryTags() tea.Cmd {
+	m.State = QueryTagsState
+	m.Input.Placeholder = "Enter query, e.g. (home OR errands) AND NOT done"
+	m.Input.SetValue(m.Query)
+	m.Input.CursorEnd()
+	m.Input.Focus()
+	return nil
+}
+
+// QueryTags parses the query entered in the input and opens the items
+// matching it, or reports why the query is invalid.
+func (m *MenuModel) QueryTags() tea.Cmd {
+	query, err := service.ParseTagQuery(m.Input.Value())
+	if err != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(fmt.Sprintf("invalid query: %v", err)),
+		)
+	}
+	m.Query = query.String()
+	return func() tea.Msg {
+		return navigation.OpenTagQueryMsg{Query: query.String()}
+	}
 }
 
 // DeleteTag deletes current selected tag.
@@ -77,6 +245,11 @@ func (m *MenuModel) DeleteTag() tea.Cmd {
 func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	if m.State != DefaultState {
+		m.Input, cmds = m.HandleInputState(msg)
+		return m, tea.Batch(cmds...)
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		cmd := m.HandleWindowSize(msg)
@@ -91,7 +264,25 @@ func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 
 	case ListTagsMsg:
-		m.List.SetItems(NewList(msg.Tags))
+		m.setTree(msg.Tags)
+
+	case RenameTagMsg:
+		cmd := m.HandleRenameTag(msg)
+		cmds = append(cmds, cmd)
+		cmd = m.ListTags()
+		cmds = append(cmds, cmd)
+
+	case UpdateTagMetadataMsg:
+		cmd := m.HandleUpdateTagMetadata(msg)
+		cmds = append(cmds, cmd)
+		cmd = m.ListTags()
+		cmds = append(cmds, cmd)
+
+	case MergeTagMsg:
+		cmd := m.HandleMergeTag(msg)
+		cmds = append(cmds, cmd)
+		cmd = m.ListTags()
+		cmds = append(cmds, cmd)
 
 	case DeleteTagMsg:
 		cmd := m.HandleDeleteTag(msg)
@@ -3,19 +3,24 @@ package tags
 import (
 	"testing"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
+	"github.com/rhajizada/donezo/internal/tui/navigation"
 )
 
 func TestCopyAndDeleteTag(t *testing.T) {
 	tests := []struct {
-		name string
-		tag  string
+		name    string
+		tag     string
+		itemTag string
 	}{
-		{name: "copy markdown then delete tag", tag: "work"},
+		{name: "copy markdown then delete tag", tag: "work", itemTag: "work"},
+		{name: "deleting a parent row deletes its subtree", tag: "work", itemTag: "work/infra"},
 	}
 
 	for _, tt := range tests {
@@ -26,9 +31,9 @@ func TestCopyAndDeleteTag(t *testing.T) {
 			ctx := testutil.MustContext()
 			board, err := svc.CreateBoard(ctx, "Inbox")
 			require.NoError(t, err)
-			item, err := svc.CreateItem(ctx, board, "task", "desc")
+			item, err := svc.CreateItem(ctx, board, "task", "desc", nil)
 			require.NoError(t, err)
-			item.Tags = []string{tt.tag}
+			item.Tags = []string{tt.itemTag}
 			_, err = svc.UpdateItem(ctx, item)
 			require.NoError(t, err)
 
@@ -37,19 +42,21 @@ func TestCopyAndDeleteTag(t *testing.T) {
 			model, _ := menu.Update(msg)
 			menu = model.(MenuModel)
 			menu.List.Select(0)
+			selected, ok := menu.selectedItem()
+			require.True(t, ok)
+			require.Equal(t, tt.tag, selected.Tag)
 
 			itemsForTag, err := svc.ListItemsByTag(ctx, tt.tag)
 			require.NoError(t, err)
 			expected := service.ItemsToMarkdown(tt.tag, *itemsForTag)
 
-			var captured []byte
-			prevWrite := writeClipboardText
-			writeClipboardText = func(data []byte) { captured = append([]byte{}, data...) }
-			defer func() { writeClipboardText = prevWrite }()
+			menu.Clipboard = clipboard.NewRegister("")
 
 			if cmd := menu.Copy(); cmd != nil {
 				cmd()
 			}
+			captured, err := menu.Clipboard.Read()
+			require.NoError(t, err)
 			assert.Equal(t, expected, string(captured))
 
 			delCmd := menu.DeleteTag()
@@ -70,3 +77,313 @@ func TestCopyAndDeleteTag(t *testing.T) {
 		})
 	}
 }
+
+func TestRenameAndMergeTagFromInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       tea.KeyPressMsg
+		wantState InputState
+		input     string
+		wantTags  []string
+		wantCount int64
+	}{
+		{
+			name:      "rename selected tag",
+			key:       tea.KeyPressMsg{Code: 'r', Text: "r"},
+			wantState: RenameTagState,
+			input:     "chores",
+			wantTags:  []string{"chores", "work"},
+			wantCount: 2,
+		},
+		{
+			name:      "merge selected tag into existing tag",
+			key:       tea.KeyPressMsg{Code: 'm', Text: "m"},
+			wantState: MergeTagState,
+			input:     "work",
+			wantTags:  []string{"work"},
+			wantCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, cleanup := testutil.NewTestService(t)
+			defer cleanup()
+
+			ctx := testutil.MustContext()
+			board, err := svc.CreateBoard(ctx, "Inbox")
+			require.NoError(t, err)
+			for _, tags := range [][]string{{"wrok"}, {"work", "wrok"}} {
+				item, createErr := svc.CreateItem(ctx, board, "task", "desc", nil)
+				require.NoError(t, createErr)
+				item.Tags = tags
+				_, err = svc.UpdateItem(ctx, item)
+				require.NoError(t, err)
+			}
+
+			menu := NewModel(ctx, svc)
+			model, _ := menu.Update(menu.ListTags()())
+			menu = model.(MenuModel)
+			menu.List.Select(1)
+			require.Equal(t, "wrok", menu.List.SelectedItem().(Item).Tag)
+
+			model, _ = menu.Update(tt.key)
+			menu = model.(MenuModel)
+			require.Equal(t, tt.wantState, menu.State)
+			menu.Input.SetValue(tt.input)
+
+			model, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			menu = model.(MenuModel)
+			assert.Equal(t, DefaultState, menu.State)
+			var result tea.Msg
+			for _, msg := range collectBatch(cmd) {
+				switch msg.(type) {
+				case RenameTagMsg, MergeTagMsg:
+					result = msg
+				}
+			}
+			require.NotNil(t, result)
+
+			model, cmd = menu.Update(result)
+			menu = model.(MenuModel)
+			for _, msg := range collectBatch(cmd) {
+				if listed, ok := msg.(ListTagsMsg); ok {
+					model, _ = menu.Update(listed)
+					menu = model.(MenuModel)
+				}
+			}
+
+			var tags []string
+			for _, li := range menu.List.Items() {
+				tags = append(tags, li.(Item).Tag)
+			}
+			assert.Equal(t, tt.wantTags, tags)
+			count, err := svc.CountItemsByTag(ctx, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCount, count)
+		})
+	}
+}
+
+func collectBatch(cmd tea.Cmd) []tea.Msg {
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		if c != nil {
+			msgs = append(msgs, collectBatch(c)...)
+		}
+	}
+	return msgs
+}
+
+func TestEditTagMetadataFromInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputs    []string
+		wantErr   bool
+		wantTitle string
+		wantDesc  string
+	}{
+		{
+			name:      "saves color emoji and description",
+			inputs:    []string{"#7D56F4", "💼", "day job"},
+			wantTitle: "💼 work",
+			wantDesc:  "day job",
+		},
+		{
+			name:      "invalid color is rejected",
+			inputs:    []string{"purple", "", ""},
+			wantErr:   true,
+			wantTitle: "work",
+			wantDesc:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			menu, cleanup := newTagMenu(t)
+			defer cleanup()
+
+			model, _ := menu.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+			menu = model.(MenuModel)
+			wantStates := []InputState{EditColorState, EditEmojiState, EditDescState}
+			var cmd tea.Cmd
+			for i, input := range tt.inputs {
+				require.Equal(t, wantStates[i], menu.State)
+				menu.Input.SetValue(input)
+				model, cmd = menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+				menu = model.(MenuModel)
+			}
+			assert.Equal(t, DefaultState, menu.State)
+
+			var result UpdateTagMetadataMsg
+			for _, msg := range collectBatch(cmd) {
+				if updated, ok := msg.(UpdateTagMetadataMsg); ok {
+					result = updated
+				}
+			}
+			if tt.wantErr {
+				require.Error(t, result.Error)
+			} else {
+				require.NoError(t, result.Error)
+			}
+
+			model, _ = menu.Update(menu.ListTags()())
+			menu = model.(MenuModel)
+			selected := menu.List.Items()[0].(Item)
+			assert.Equal(t, tt.wantTitle, selected.Title())
+			assert.Equal(t, tt.wantDesc, selected.Meta.Description)
+		})
+	}
+}
+
+func TestTagTreeRollsUpCountsAndCollapses(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board, err := svc.CreateBoard(ctx, "Inbox")
+	require.NoError(t, err)
+	for _, tags := range [][]string{{"work/infra"}, {"work/frontend", "work/infra"}, {"home"}} {
+		item, createErr := svc.CreateItem(ctx, board, "task", "desc", nil)
+		require.NoError(t, createErr)
+		item.Tags = tags
+		_, err = svc.UpdateItem(ctx, item)
+		require.NoError(t, err)
+	}
+
+	menu := NewModel(ctx, svc)
+	model, _ := menu.Update(menu.ListTags()())
+	menu = model.(MenuModel)
+
+	listed := func() map[string]int64 {
+		counts := map[string]int64{}
+		for _, li := range menu.List.Items() {
+			counts[li.(Item).Tag] = li.(Item).Count
+		}
+		return counts
+	}
+	assert.Equal(t, map[string]int64{"home": 1, "work": 2, "work/frontend": 1, "work/infra": 2}, listed())
+
+	menu.List.Select(1)
+	require.Equal(t, "work", menu.List.SelectedItem().(Item).Tag)
+	model, _ = menu.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	menu = model.(MenuModel)
+	assert.Equal(t, map[string]int64{"home": 1, "work": 2}, listed())
+	assert.Equal(t, "work", menu.List.SelectedItem().(Item).Tag)
+
+	// The tree stays collapsed across reloads.
+	model, _ = menu.Update(menu.ListTags()())
+	menu = model.(MenuModel)
+	assert.Len(t, menu.List.Items(), 2)
+
+	model, _ = menu.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	menu = model.(MenuModel)
+	assert.Len(t, menu.List.Items(), 4)
+}
+
+func TestSortTagsCyclesOrders(t *testing.T) {
+	svc, cleanup := testutil.NewTestService(t)
+	defer cleanup()
+
+	ctx := testutil.MustContext()
+	board, err := svc.CreateBoard(ctx, "Inbox")
+	require.NoError(t, err)
+	for _, tt := range []struct {
+		tags      []string
+		completed bool
+	}{
+		{tags: []string{"alpha"}, completed: true},
+		{tags: []string{"beta/one"}},
+		{tags: []string{"beta/two"}},
+		{tags: []string{"beta/two"}},
+	} {
+		item, createErr := svc.CreateItem(ctx, board, "task", "desc", nil)
+		require.NoError(t, createErr)
+		item.Tags = tt.tags
+		item.Completed = tt.completed
+		_, err = svc.UpdateItem(ctx, item)
+		require.NoError(t, err)
+	}
+
+	menu := NewModel(ctx, svc)
+	model, _ := menu.Update(menu.ListTags()())
+	menu = model.(MenuModel)
+
+	listed := func() []string {
+		var tags []string
+		for _, li := range menu.List.Items() {
+			tags = append(tags, li.(Item).Tag)
+		}
+		return tags
+	}
+	assert.Equal(t, []string{"alpha", "beta", "beta/one", "beta/two"}, listed())
+	alpha := menu.List.Items()[0].(Item)
+	assert.Equal(t, int64(0), alpha.Open)
+	assert.Equal(t, int64(1), alpha.Done)
+
+	model, cmd := menu.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	menu = model.(MenuModel)
+	assert.Equal(t, service.OrderTagsByOpen, menu.Order)
+	assert.Equal(t, []string{"beta", "beta/two", "beta/one", "alpha"}, listed())
+	assert.NotNil(t, cmd)
+
+	// Reloading keeps the chosen order.
+	model, _ = menu.Update(menu.ListTags()())
+	menu = model.(MenuModel)
+	assert.Equal(t, []string{"beta", "beta/two", "beta/one", "alpha"}, listed())
+}
+
+func TestQueryTagsFromInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantQuery string
+		wantErr   string
+	}{
+		{name: "valid query opens items", input: "work and not later", wantQuery: "work AND NOT later"},
+		{name: "invalid query reports parse error", input: "work AND", wantErr: "unexpected end of query"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			menu, cleanup := newTagMenu(t)
+			defer cleanup()
+			menu.List.SetSize(200, 20)
+
+			model, _ := menu.Update(tea.KeyPressMsg{Code: 'Q', Text: "Q"})
+			menu = model.(MenuModel)
+			require.Equal(t, QueryTagsState, menu.State)
+
+			menu.Input.SetValue(tt.input)
+			model, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			menu = model.(MenuModel)
+			assert.Equal(t, DefaultState, menu.State)
+			if tt.wantErr != "" {
+				assert.Contains(t, menu.List.View(), tt.wantErr)
+			}
+
+			var opened *navigation.OpenTagQueryMsg
+			for _, msg := range collectBatch(cmd) {
+				if open, ok := msg.(navigation.OpenTagQueryMsg); ok {
+					opened = &open
+				}
+			}
+			if tt.wantErr != "" {
+				assert.Nil(t, opened)
+				return
+			}
+			require.NotNil(t, opened)
+			assert.Equal(t, tt.wantQuery, opened.Query)
+
+			// Reopening the prompt starts from the last query.
+			model, _ = menu.Update(tea.KeyPressMsg{Code: 'Q', Text: "Q"})
+			menu = model.(MenuModel)
+			assert.Equal(t, tt.wantQuery, menu.Input.Value())
+		})
+	}
+}
@@ -7,5 +7,9 @@ import (
 )
 
 func (m MenuModel) View() tea.View {
-	return tea.NewView(styles.App.Render(m.List.View()))
+	content := styles.App.Render(m.List.View())
+	if m.State != DefaultState {
+		content = styles.App.Render(m.Input.View())
+	}
+	return tea.NewView(content)
 }
@@ -0,0 +1,81 @@
+package trash
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rhajizada/donezo/internal/tui/navigation"
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+// HandleWindowSize processes window size messages.
+func (m *MenuModel) HandleWindowSize(msg tea.WindowSizeMsg) tea.Cmd {
+	h, v := styles.App.GetFrameSize()
+	m.List.SetSize(msg.Width-h, msg.Height-v)
+	return nil
+}
+
+// HandleError  processes errors and displays error messages.
+func (m *MenuModel) HandleError(msg ErrorMsg) tea.Cmd {
+	formattedMsg := fmt.Sprintf("error: %v", msg.Error)
+	return m.List.NewStatusMessage(
+		styles.ErrorMessage.Render(formattedMsg),
+	)
+}
+
+// HandleRestore handles RestoreMsg.
+func (m *MenuModel) HandleRestore(msg RestoreMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed restoring %s: %v", msg.Entry.Kind(), msg.Error),
+			),
+		)
+	}
+
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("restored %s \"%s\"", msg.Entry.Kind(), msg.Entry.Name()),
+		),
+	)
+}
+
+// HandlePurge handles PurgeMsg.
+func (m *MenuModel) HandlePurge(msg PurgeMsg) tea.Cmd {
+	if msg.Error != nil {
+		return m.List.NewStatusMessage(
+			styles.ErrorMessage.Render(
+				fmt.Sprintf("failed deleting %s: %v", msg.Entry.Kind(), msg.Error),
+			),
+		)
+	}
+
+	return m.List.NewStatusMessage(
+		styles.StatusMessage.Render(
+			fmt.Sprintf("permanently deleted %s \"%s\"", msg.Entry.Kind(), msg.Entry.Name()),
+		),
+	)
+}
+
+// HandleKeyInput processes key inputs not handles by list.Model.
+func (m *MenuModel) HandleKeyInput(msg tea.KeyPressMsg) tea.Cmd {
+	var cmd tea.Cmd
+	if !m.List.SettingFilter() {
+		if !key.Matches(msg, m.Keys.Purge) {
+			m.purging = nil
+		}
+		switch {
+		case key.Matches(msg, m.Keys.Restore):
+			cmd = m.Restore()
+		case key.Matches(msg, m.Keys.Purge):
+			cmd = m.ConfirmPurge()
+		case key.Matches(msg, m.Keys.RefreshList):
+			cmd = m.ListTrash()
+		case key.Matches(msg, m.Keys.Back):
+			cmd = func() tea.Msg { return navigation.BackMsg{} }
+		}
+	}
+	return cmd
+}
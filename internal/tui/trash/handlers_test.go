@@ -0,0 +1,140 @@
+package trash
+
+import (
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/testutil"
+	"github.com/rhajizada/donezo/internal/tui/navigation"
+)
+
+// newTrashMenu returns a trash menu listing a deleted board "Archive" and a
+// deleted item "task" from board "Inbox".
+func newTrashMenu(t *testing.T) (MenuModel, func()) {
+	t.Helper()
+	svc, cleanup := testutil.NewTestService(t)
+	ctx := testutil.MustContext()
+	archive, err := svc.CreateBoard(ctx, "Archive")
+	require.NoError(t, err)
+	inbox, err := svc.CreateBoard(ctx, "Inbox")
+	require.NoError(t, err)
+	item, err := svc.CreateItem(ctx, inbox, "task", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, svc.DeleteBoard(ctx, archive))
+	require.NoError(t, svc.DeleteItem(ctx, item))
+
+	boards, err := svc.ListDeletedBoards(ctx)
+	require.NoError(t, err)
+	items, err := svc.ListDeletedItems(ctx)
+	require.NoError(t, err)
+	menu := New(ctx, svc)
+	menu.List.SetItems(NewList(boards, items))
+	menu.List.Select(0)
+	return menu, cleanup
+}
+
+func TestTrashKeyBindings(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       tea.KeyPressMsg
+		assertCmd func(*testing.T, tea.Cmd)
+	}{
+		{
+			name: "restore sends restore message",
+			msg:  tea.KeyPressMsg{Code: 'u', Text: "u"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				msg, ok := cmd().(RestoreMsg)
+				require.True(t, ok)
+				require.NoError(t, msg.Error)
+				assert.Equal(t, "Archive", msg.Entry.Name())
+			},
+		},
+		{
+			name: "delete twice sends purge message",
+			msg:  tea.KeyPressMsg{Code: 'd', Text: "d"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				msg, ok := cmd().(PurgeMsg)
+				require.True(t, ok)
+				require.NoError(t, msg.Error)
+				assert.Equal(t, "board", msg.Entry.Kind())
+			},
+		},
+		{
+			name: "refresh sends list message",
+			msg:  tea.KeyPressMsg{Code: 'R', Text: "R"},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				msg, ok := cmd().(ListTrashMsg)
+				require.True(t, ok)
+				assert.Len(t, *msg.Boards, 1)
+				assert.Len(t, *msg.Items, 1)
+			},
+		},
+		{
+			name: "backspace navigates back",
+			msg:  tea.KeyPressMsg{Code: tea.KeyBackspace},
+			assertCmd: func(t *testing.T, cmd tea.Cmd) {
+				require.NotNil(t, cmd)
+				assert.Equal(t, navigation.BackMsg{}, cmd())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			menu, cleanup := newTrashMenu(t)
+			defer cleanup()
+
+			model, cmd := menu.Update(tt.msg)
+			if key.Matches(tt.msg, menu.Keys.Purge) {
+				model, cmd = model.Update(tt.msg)
+			}
+			tt.assertCmd(t, cmd)
+		})
+	}
+}
+
+func TestTrashPurgeConfirmation(t *testing.T) {
+	menu, cleanup := newTrashMenu(t)
+	defer cleanup()
+
+	press := func(k rune) tea.Cmd {
+		t.Helper()
+		model, cmd := menu.Update(tea.KeyPressMsg{Code: k, Text: string(k)})
+		menu = model.(MenuModel)
+		return cmd
+	}
+
+	// One entry per page, so paging would move the selection off the board.
+	menu.List.SetSize(80, 10)
+	require.Equal(t, 2, menu.List.Paginator.TotalPages)
+
+	// Neither key pages the list, so the selection stays on the board.
+	press('d')
+	assert.Equal(t, 0, menu.List.Index())
+	require.NotNil(t, menu.purging)
+	assert.Equal(t, "Archive", menu.purging.Name())
+
+	// Any other key cancels the pending purge.
+	press('u')
+	assert.Equal(t, 0, menu.List.Index())
+	assert.Nil(t, menu.purging)
+
+	// A new selection asks again.
+	press('d')
+	menu.List.Select(1)
+	press('d')
+	require.NotNil(t, menu.purging)
+	assert.Equal(t, "task", menu.purging.Name())
+
+	msg, ok := press('d')().(PurgeMsg)
+	require.True(t, ok)
+	require.NoError(t, msg.Error)
+	assert.Equal(t, "task", msg.Entry.Name())
+}
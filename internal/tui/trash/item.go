@@ -0,0 +1,65 @@
+package trash
+
+import (
+	"fmt"
+	"time"
+
+	"charm.land/bubbles/v2/list"
+
+	"github.com/rhajizada/donezo/internal/service"
+)
+
+// Item represents a deleted board or a deleted item in the list. Exactly one
+// of Board and Itm is set.
+type Item struct {
+	Board *service.Board
+	Itm   *service.DeletedItem
+}
+
+// NewList lists deleted boards before deleted items.
+func NewList(boards *[]service.Board, items *[]service.DeletedItem) []list.Item {
+	l := make([]list.Item, 0, len(*boards)+len(*items))
+	for i := range *boards {
+		l = append(l, Item{Board: &(*boards)[i]})
+	}
+	for i := range *items {
+		l = append(l, Item{Itm: &(*items)[i]})
+	}
+	return l
+}
+
+// Name returns the name of the deleted board or the title of the deleted item.
+func (i Item) Name() string {
+	if i.Board != nil {
+		return i.Board.Name
+	}
+	return i.Itm.Title
+}
+
+// Kind returns "board" or "item".
+func (i Item) Kind() string {
+	if i.Board != nil {
+		return "board"
+	}
+	return "item"
+}
+
+func (i Item) deletedAt() *time.Time {
+	if i.Board != nil {
+		return i.Board.DeletedAt
+	}
+	return i.Itm.DeletedAt
+}
+
+func (i Item) Title() string { return i.Name() }
+func (i Item) Description() string {
+	var deleted string
+	if at := i.deletedAt(); at != nil {
+		deleted = " | deleted " + at.Local().Format("01-02-2006 15:04")
+	}
+	if i.Board != nil {
+		return "Board" + deleted
+	}
+	return fmt.Sprintf("Item on %s%s", i.Itm.BoardName, deleted)
+}
+func (i Item) FilterValue() string { return i.Name() }
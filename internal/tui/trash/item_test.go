@@ -0,0 +1,57 @@
+package trash_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/trash"
+)
+
+func TestTrashItemAccessors(t *testing.T) {
+	deletedAt := time.Date(2024, time.March, 5, 10, 30, 0, 0, time.Local)
+	boards := []service.Board{{Board: repository.Board{Name: "Archive", DeletedAt: &deletedAt}}}
+	items := []service.DeletedItem{{
+		Item:      service.Item{Item: repository.Item{Title: "task", DeletedAt: &deletedAt}},
+		BoardName: "Inbox",
+	}}
+
+	list := trash.NewList(&boards, &items)
+	require.Len(t, list, 2)
+
+	tests := []struct {
+		name     string
+		entry    trash.Item
+		wantKind string
+		wantName string
+		wantDesc string
+	}{
+		{
+			name:     "deleted board",
+			entry:    list[0].(trash.Item),
+			wantKind: "board",
+			wantName: "Archive",
+			wantDesc: "Board | deleted 03-05-2024 10:30",
+		},
+		{
+			name:     "deleted item",
+			entry:    list[1].(trash.Item),
+			wantKind: "item",
+			wantName: "task",
+			wantDesc: "Item on Inbox | deleted 03-05-2024 10:30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantKind, tt.entry.Kind())
+			assert.Equal(t, tt.wantName, tt.entry.Title())
+			assert.Equal(t, tt.wantName, tt.entry.FilterValue())
+			assert.Equal(t, tt.wantDesc, tt.entry.Description())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package trash
+
+import (
+	"charm.land/bubbles/v2/key"
+
+	"github.com/rhajizada/donezo/internal/tui/keybindings"
+)
+
+// Keymap embeds default list keymap and adds other Binding.
+type Keymap struct {
+	Back        key.Binding
+	Restore     key.Binding
+	Purge       key.Binding
+	RefreshList key.Binding
+}
+
+func NewKeymap() Keymap {
+	km := Keymap{
+		Back: key.NewBinding(
+			key.WithKeys("backspace"),
+			key.WithHelp("backspace", "back"),
+		),
+		Restore: key.NewBinding(key.WithKeys("u"),
+			key.WithHelp("u", "restore"),
+		),
+		Purge: key.NewBinding(key.WithKeys("d"),
+			key.WithHelp("d", "delete permanently"),
+		),
+		RefreshList: key.NewBinding(key.WithKeys("R"),
+			key.WithHelp("R", "refresh list"),
+		),
+	}
+	keybindings.Apply("trash", &km)
+	return km
+}
+
+func (km Keymap) ShortHelp() []key.Binding {
+	bindings := []key.Binding{}
+	bindings = append(bindings, km.Back)
+	bindings = append(bindings, km.Restore)
+	return bindings
+}
+
+func (km Keymap) FullHelp() []key.Binding {
+	bindings := []key.Binding{}
+	bindings = append(bindings, km.Back)
+	bindings = append(bindings, km.Restore)
+	bindings = append(bindings, km.Purge)
+	bindings = append(bindings, km.RefreshList)
+	return bindings
+}
@@ -0,0 +1,22 @@
+package trash
+
+import "github.com/rhajizada/donezo/internal/service"
+
+type ErrorMsg struct {
+	Error error
+}
+
+type ListTrashMsg struct {
+	Boards *[]service.Board
+	Items  *[]service.DeletedItem
+}
+
+type RestoreMsg struct {
+	Entry Item
+	Error error
+}
+
+type PurgeMsg struct {
+	Entry Item
+	Error error
+}
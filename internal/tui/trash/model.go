@@ -0,0 +1,47 @@
+package trash
+
+import (
+	"context"
+
+	"charm.land/bubbles/v2/list"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rhajizada/donezo/internal/service"
+	"github.com/rhajizada/donezo/internal/tui/itemlist"
+)
+
+//nolint:recvcheck // Bubble Tea models intentionally mix value/pointer receivers for tea.Model interface.
+type MenuModel struct {
+	ctx    context.Context
+	List   list.Model
+	Keys   *Keymap
+	Client *service.Service
+	// purging is the entry waiting for the purge key to be pressed again.
+	purging *Item
+}
+
+func (m MenuModel) Init() tea.Cmd {
+	return m.ListTrash()
+}
+
+// New constructs the trash view listing deleted boards and items.
+func New(ctx context.Context, client *service.Service) MenuModel {
+	list := list.New(
+		[]list.Item{},
+		list.NewDefaultDelegate(),
+		0,
+		0,
+	)
+	keymap := NewKeymap()
+	list.KeyMap = itemlist.DefaultKeyMap().List()
+	list.Title = "Trash"
+	list.SetStatusBarItemName("entry", "entries")
+	list.AdditionalShortHelpKeys = keymap.ShortHelp
+	list.AdditionalFullHelpKeys = keymap.FullHelp
+	return MenuModel{
+		ctx:    ctx,
+		List:   list,
+		Keys:   &keymap,
+		Client: client,
+	}
+}
@@ -0,0 +1,133 @@
+package trash
+
+import (
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rhajizada/donezo/internal/tui/styles"
+)
+
+func (m *MenuModel) selectedItem() (Item, bool) {
+	item, ok := m.List.SelectedItem().(Item)
+	return item, ok
+}
+
+// ListTrash fetches deleted boards and items.
+func (m *MenuModel) ListTrash() tea.Cmd {
+	return func() tea.Msg {
+		boards, err := m.Client.ListDeletedBoards(m.ctx)
+		if err != nil {
+			return ErrorMsg{err}
+		}
+		items, err := m.Client.ListDeletedItems(m.ctx)
+		if err != nil {
+			return ErrorMsg{err}
+		}
+		return ListTrashMsg{
+			Boards: boards,
+			Items:  items,
+		}
+	}
+}
+
+// Restore moves selected entry out of the trash.
+func (m *MenuModel) Restore() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("trash is empty"))
+	}
+	return func() tea.Msg {
+		var err error
+		if selected.Board != nil {
+			err = m.Client.RestoreBoard(m.ctx, selected.Board)
+		} else {
+			err = m.Client.RestoreItem(m.ctx, &selected.Itm.Item)
+		}
+		return RestoreMsg{Entry: selected, Error: err}
+	}
+}
+
+// ConfirmPurge asks to press the purge key again before the selected entry is
+// permanently deleted, and purges it on the second press.
+func (m *MenuModel) ConfirmPurge() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("trash is empty"))
+	}
+	if m.purging != nil && *m.purging == selected {
+		m.purging = nil
+		return m.Purge()
+	}
+	m.purging = &selected
+	return m.List.NewStatusMessage(styles.ErrorMessage.Render(fmt.Sprintf(
+		"press %s again to permanently delete %s \"%s\"",
+		m.Keys.Purge.Help().Key, selected.Kind(), selected.Name(),
+	)))
+}
+
+// Purge permanently deletes selected entry.
+func (m *MenuModel) Purge() tea.Cmd {
+	selected, ok := m.selectedItem()
+	if !ok {
+		return m.List.NewStatusMessage(styles.ErrorMessage.Render("trash is empty"))
+	}
+	return func() tea.Msg {
+		var err error
+		if selected.Board != nil {
+			err = m.Client.PurgeBoard(m.ctx, selected.Board)
+		} else {
+			err = m.Client.PurgeItem(m.ctx, &selected.Itm.Item)
+		}
+		return PurgeMsg{Entry: selected, Error: err}
+	}
+}
+
+func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		cmd := m.HandleWindowSize(msg)
+		cmds = append(cmds, cmd)
+
+	case tea.KeyPressMsg:
+		// Restore and purge act on the selected entry, so the list must not
+		// also page on their keys.
+		if !m.List.SettingFilter() && key.Matches(msg, m.Keys.Restore, m.Keys.Purge) {
+			return m, m.HandleKeyInput(msg)
+		}
+		cmd := m.HandleKeyInput(msg)
+		cmds = append(cmds, cmd)
+
+	case ErrorMsg:
+		cmd := m.HandleError(msg)
+		cmds = append(cmds, cmd)
+
+	case ListTrashMsg:
+		m.List.SetItems(NewList(msg.Boards, msg.Items))
+
+	case RestoreMsg:
+		cmd := m.HandleRestore(msg)
+		cmds = append(cmds, cmd)
+		cmd = m.ListTrash()
+		cmds = append(cmds, cmd)
+
+	case PurgeMsg:
+		cmd := m.HandlePurge(msg)
+		cmds = append(cmds, cmd)
+		cmd = m.ListTrash()
+		cmds = append(cmds, cmd)
+	}
+
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && keyMsg.Code == tea.KeyEsc {
+		return m, tea.Batch(cmds...)
+	}
+
+	listModel, listCmd := m.List.Update(msg)
+	m.List = listModel
+	cmds = append(cmds, listCmd)
+
+	return m, tea.Batch(cmds...)
+}
@@ -0,0 +1,44 @@
+package trash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashRestoreAndPurgeFlow(t *testing.T) {
+	menu, cleanup := newTrashMenu(t)
+	defer cleanup()
+
+	apply := func(msg any) {
+		t.Helper()
+		model, _ := menu.Update(msg)
+		menu = model.(MenuModel)
+	}
+
+	// The item goes back to the end of its board.
+	menu.List.Select(1)
+	apply(menu.Restore()())
+	apply(menu.ListTrash()())
+	require.Len(t, menu.List.Items(), 1)
+	assert.Equal(t, "Archive", menu.List.Items()[0].(Item).Name())
+
+	boards, err := menu.Client.ListBoards(menu.ctx)
+	require.NoError(t, err)
+	require.Len(t, *boards, 1)
+	items, err := menu.Client.ListItemsByBoard(menu.ctx, &(*boards)[0])
+	require.NoError(t, err)
+	require.Len(t, *items, 1)
+	assert.Equal(t, "task", (*items)[0].Title)
+
+	// Purging the board removes it for good.
+	apply(menu.Purge()())
+	apply(menu.ListTrash()())
+	assert.Empty(t, menu.List.Items())
+
+	deleted, err := menu.Client.ListDeletedBoards(menu.ctx)
+	require.NoError(t, err)
+	assert.Empty(t, *deleted)
+	assert.NotNil(t, menu.Restore(), "empty trash reports a status message")
+}
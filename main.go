@@ -6,16 +6,23 @@ import (
 	"embed"
 	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
+	"time"
 
-	"github.com/pressly/goose/v3"
-	"golang.design/x/clipboard"
+	"golang.org/x/term"
 
-	"github.com/rhajizada/donezo/internal/repository"
+	"github.com/rhajizada/donezo/internal/cli"
+	"github.com/rhajizada/donezo/internal/config"
+	"github.com/rhajizada/donezo/internal/migrate"
+	"github.com/rhajizada/donezo/internal/paths"
 	"github.com/rhajizada/donezo/internal/service"
 	"github.com/rhajizada/donezo/internal/tui/app"
+	"github.com/rhajizada/donezo/internal/tui/clipboard"
+	"github.com/rhajizada/donezo/internal/tui/styles"
 
 	tea "charm.land/bubbletea/v2"
 	_ "github.com/mattn/go-sqlite3"
@@ -26,33 +33,85 @@ var migrations embed.FS
 
 var Version = "dev" //nolint:gochecknoglobals // overridden at build time via ldflags
 
+// defaultTrashRetention is how long deleted boards and items stay in the trash.
+const defaultTrashRetention = 30 * 24 * time.Hour
+
 func main() {
-	if err := run(); err != nil {
-		log.Panic(err)
+	code, err := run()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "donezo: %v\n", err)
+		code = cli.ExitError
 	}
+	os.Exit(code)
 }
 
-func run() error {
+// run starts the TUI, or runs the subcommand given on the command line, and
+// returns the exit code.
+func run() (int, error) {
 	versionFlag := flag.Bool("version", false, "Print version information and exit")
+	trashRetention := flag.Duration(
+		"trash-retention",
+		defaultTrashRetention,
+		"Permanently delete trashed boards and items older than this (0 keeps them forever)",
+	)
+	dbFlag := flag.String(
+		"db",
+		"",
+		"Path to the SQLite database (default $"+paths.EnvDB+", the config file or $XDG_DATA_HOME/donezo/data.db)",
+	)
+	configFlag := flag.String(
+		"config",
+		"",
+		"Path to the config file (default $XDG_CONFIG_HOME/donezo/config.yaml)",
+	)
+	noMigrate := flag.Bool(
+		"no-migrate",
+		false,
+		"Refuse to start when the database has pending migrations instead of applying them",
+	)
+	flag.Usage = func() {
+		cli.PrintUsage(flag.CommandLine.Output())
+		fmt.Fprintln(flag.CommandLine.Output(), "\nFlags:")
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
 	if *versionFlag {
 		fmt.Fprintf(os.Stdout, "donezo %s\n", Version)
-		return nil
+		return cli.ExitOK, nil
 	}
 
-	if err := clipboard.Init(); err != nil {
-		return fmt.Errorf("unable to access system clipboard: %w", err)
+	args := flag.Args()
+	if len(args) > 0 && args[0] == cli.CompleteCommand {
+		args = completionArgs(args)
 	}
 
-	dbPath, err := ensureDataDir()
+	cfg, err := loadConfig(*configFlag)
 	if err != nil {
-		return err
+		return cli.ExitError, err
+	}
+
+	if !cli.IsCommand(args) {
+		styles.SetPalette(cfg.Colors.Palette())
+		if err = app.ConfigureKeys(cfg.Keys); err != nil {
+			return cli.ExitError, fmt.Errorf("invalid keybindings in config: %w", err)
+		}
+		if err = setupClipboard(cfg.Clipboard); err != nil {
+			return cli.ExitError, err
+		}
+	}
+
+	dbPath, err := paths.ResolveDB(*dbFlag, cfg.DB)
+	if err != nil {
+		return cli.ExitError, err
 	}
 
-	db, err := sql.Open("sqlite3", dbPath)
+	// Foreign keys are enforced per connection, so they have to be enabled in
+	// the DSN for ON DELETE CASCADE to apply. Completion runs on every tab
+	// press and only reads.
+	db, err := sql.Open("sqlite3", paths.DSN(dbPath, cli.IsCompletion(args)))
 	if err != nil {
-		return fmt.Errorf("failed to open database %s: %w", dbPath, err)
+		return cli.ExitError, fmt.Errorf("failed to open database %s: %w", dbPath, err)
 	}
 	defer func() {
 		if cerr := db.Close(); cerr != nil {
@@ -60,54 +119,128 @@ func run() error {
 		}
 	}()
 
-	if migrateErr := runMigrations(db); migrateErr != nil {
-		return migrateErr
+	ctx := context.Background()
+	migrationsFS, err := fs.Sub(migrations, "data/sql/migrations")
+	if err != nil {
+		return cli.ExitError, err
 	}
+	migrator, err := migrate.New(db, dbPath, migrationsFS)
+	if err != nil {
+		return cli.ExitError, err
+	}
+	s := service.New(db)
 
-	r := repository.New(db)
-	s := service.New(r)
-	ctx := context.Background()
+	// The db commands manage the schema themselves, and completion neither
+	// migrates nor purges the trash.
+	if cli.ManagesSchema(args) || cli.IsCompletion(args) {
+		return cli.Run(ctx, s, migrator, args, os.Stdin, os.Stdout, os.Stderr), nil
+	}
+	if migrateErr := runMigrations(ctx, migrator, dbPath, *noMigrate); migrateErr != nil {
+		return cli.ExitError, migrateErr
+	}
+
+	if *trashRetention > 0 {
+		if _, purgeErr := s.PurgeTrash(ctx, *trashRetention); purgeErr != nil {
+			return cli.ExitError, fmt.Errorf("failed to empty trash: %w", purgeErr)
+		}
+	}
 
-	m := app.New(ctx, s)
+	if cli.IsCommand(args) {
+		return cli.Run(ctx, s, migrator, args, os.Stdin, os.Stdout, os.Stderr), nil
+	}
+
+	m := app.New(ctx, s, app.Options{
+		DBPath:        dbPath,
+		DefaultBoard:  cfg.DefaultBoard,
+		HideCompleted: cfg.HideCompleted,
+	})
 	p := tea.NewProgram(m)
 
 	if _, programErr := p.Run(); programErr != nil {
-		return fmt.Errorf("error running program: %w", programErr)
+		return cli.ExitError, fmt.Errorf("error running program: %w", programErr)
 	}
 
-	return nil
+	return cli.ExitOK, nil
 }
 
-func ensureDataDir() (string, error) {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("unable to determine user home directory: %w", err)
+// completionArgs applies the global flags such as --db found on the command
+// line being completed, so candidates come from the database it would use,
+// and returns the completion command with the remaining words. The word under
+// the cursor is left alone as it may be an incomplete flag.
+func completionArgs(args []string) []string {
+	words := args[1:]
+	if len(words) < 2 { //nolint:mnd // a completed word and the current one
+		return args
+	}
+	flag.CommandLine.Init(flag.CommandLine.Name(), flag.ContinueOnError)
+	flag.CommandLine.SetOutput(io.Discard)
+	if err := flag.CommandLine.Parse(words[:len(words)-1]); err != nil {
+		return args
 	}
+	return append(append([]string{args[0]}, flag.Args()...), words[len(words)-1])
+}
 
-	donezoDir := filepath.Join(homeDir, ".donezo")
-	if _, err = os.Stat(donezoDir); err != nil {
-		if os.IsNotExist(err) {
-			if mkErr := os.Mkdir(donezoDir, 0o700); mkErr != nil {
-				return "", fmt.Errorf("failed to create directory %s: %w", donezoDir, mkErr)
-			}
-		} else {
-			return "", fmt.Errorf("failed to check directory %s: %w", donezoDir, err)
+// loadConfig reads the config file at path, or at its default location when
+// path is empty. Only the default config file may be missing.
+func loadConfig(path string) (*config.Config, error) {
+	if path == "" {
+		var err error
+		if path, err = paths.ConfigFile(); err != nil {
+			return nil, err
 		}
+	} else if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
 	}
-
-	return filepath.Join(donezoDir, "data.db"), nil
+	return config.Load(path)
 }
 
-func runMigrations(db *sql.DB) error {
-	if err := goose.SetDialect("sqlite3"); err != nil {
-		return fmt.Errorf("failed to set Goose dialect: %w", err)
+// setupClipboard selects the clipboard of the TUI. A backend that cannot be
+// used only disables copy and paste, with a warning when the config asked
+// for it.
+func setupClipboard(name string) error {
+	env := clipboard.Environment{
+		InitSystem: clipboard.InitSystem,
+		Tmux:       os.Getenv("TMUX") != "",
 	}
+	if term.IsTerminal(int(os.Stdout.Fd())) && os.Getenv("TERM") != "dumb" {
+		env.Terminal = true
+	}
+	if dir, err := paths.DataDir(); err == nil {
+		env.RegisterPath = filepath.Join(dir, "clipboard")
+	}
+	c, err := clipboard.Select(name, env)
+	if c == nil {
+		return err
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "donezo: copy and paste are disabled: %v\n", err)
+	}
+	clipboard.Configure(c)
+	return nil
+}
 
-	goose.SetBaseFS(migrations)
-
-	if err := goose.Up(db, "data/sql/migrations"); err != nil {
-		return fmt.Errorf("failed to apply migrations: %w", err)
+// runMigrations brings the schema up to date, or with noMigrate fails when
+// it is not.
+func runMigrations(ctx context.Context, migrator *migrate.Migrator, dbPath string, noMigrate bool) error {
+	if noMigrate {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check migrations: %w", err)
+		}
+		if pending > 0 {
+			return fmt.Errorf(
+				"database %s has %d pending migrations, run \"donezo db up\" to apply them",
+				dbPath, pending,
+			)
+		}
+		return nil
 	}
 
+	if _, err := migrator.Up(ctx); err != nil {
+		return err
+	}
+	if migrator.Backup != "" {
+		fmt.Fprintf(os.Stderr, "donezo: migrated database, previous version saved to %s\n", migrator.Backup)
+	}
 	return nil
 }